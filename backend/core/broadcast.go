@@ -3,6 +3,27 @@ package core
 // BroadcastToRoomExcept broadcasts a message to all clients in a room except the specified client
 // If except is nil, broadcasts to all clients in the room
 func (h *Hub) BroadcastToRoomExcept(roomID string, except *Client, message []byte) {
+	h.broadcast(roomID, except, [][]byte{message}, func(*Client) int { return 0 })
+}
+
+// BroadcastToRoomByFeature broadcasts supported to clients that agreed to use feature in hello,
+// and unsupported to everyone else (nil to send them nothing), except the specified client
+// Used to change a payload without breaking older clients
+func (h *Hub) BroadcastToRoomByFeature(roomID string, except *Client, feature string, supported, unsupported []byte) {
+	h.broadcast(roomID, except, [][]byte{supported, unsupported}, func(client *Client) int {
+		if client.Supports(feature) {
+			return 0
+		}
+		if unsupported == nil {
+			return -1
+		}
+		return 1
+	})
+}
+
+// broadcast sends one of messages to each live client in a room except the specified client
+// pick chooses the index of the message for a client, or -1 to skip it
+func (h *Hub) broadcast(roomID string, except *Client, messages [][]byte, pick func(*Client) int) {
 	h.mu.RLock()
 	room, exists := h.rooms[roomID]
 	h.mu.RUnlock()
@@ -13,26 +34,59 @@ func (h *Hub) BroadcastToRoomExcept(roomID string, except *Client, message []byt
 
 	room.mu.RLock()
 	// Create a copy of clients to iterate over safely
-	clients := make([]*Client, 0, len(room.Clients))
-	for _, client := range room.Clients {
+	clients := make([]*Client, 0, len(room.Clients)+len(room.spectators))
+	for clientID, client := range room.Clients {
+		// Away players have no live connection - they catch up from the snapshot when they return
+		if room.isAwayLocked(clientID) {
+			continue
+		}
+		if except == nil || client != except {
+			clients = append(clients, client)
+		}
+	}
+	players := len(clients)
+	// Spectators watch everything the players see
+	for _, client := range room.spectators {
 		if except == nil || client != except {
 			clients = append(clients, client)
 		}
 	}
 	room.mu.RUnlock()
 
+	// WritePump returns every message it writes to the buffer pool, so each
+	// recipient needs its own copy - sharing one buffer would pool it twice.
+	// Copies are taken up front, before any recipient can recycle the original.
+	outgoing := make([][]byte, len(clients))
+	handedOut := make([]bool, len(messages))
+	for i, client := range clients {
+		m := pick(client)
+		if m < 0 {
+			continue
+		}
+		if !handedOut[m] {
+			outgoing[i] = messages[m]
+			handedOut[m] = true
+		} else {
+			outgoing[i] = CopyToPooledBuffer(messages[m])
+		}
+	}
+
 	// Send to each client - let SafeSend handle closed channels gracefully
-	for _, client := range clients {
+	for i, client := range clients {
+		if outgoing[i] == nil {
+			continue
+		}
 		// Use SafeSend which handles closed channels and full channels properly
-		if !client.SafeSend(message) {
+		if !client.SafeSend(outgoing[i]) {
 			// Channel is full or closed - client might be dead
 			// Check if client is still registered in hub (better check)
 			h.mu.RLock()
 			_, stillRegistered := h.clients[client]
 			h.mu.RUnlock()
 
-			if !stillRegistered {
-				// Client was unregistered, safe to remove from room
+			// Players are marked away by handleUnregister instead, which is already running for them
+			if !stillRegistered && i >= players {
+				// Spectator was unregistered, safe to remove from room
 				// Use centralized helper to remove and notify
 				h.RemoveClientFromRoom(roomID, client.ClientID, "channel closed during broadcast")
 			}
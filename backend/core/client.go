@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"turn-tracker/backend/types"
@@ -36,10 +37,19 @@ type Client struct {
 	Send           chan []byte
 	RoomID         string
 	ClientID       string
+	ResumeToken    string // Token presented to reclaim ClientID; replaced with a fresh one on registration
 	DisplayName    string // User's display name
 	Color          string // Hex color code (e.g., "#FF5733")
 	TotalTurnTime  int64  // Total time spent in turns (in milliseconds)
+	Spectator      bool   // Watching RoomID as a read-only spectator
+	WaitingRoomID  string // Room whose waitlist the client is on (empty if not waiting)
 	MessageHandler MessageHandler
+	request        clientRequest                  // Message being handled (see handleMessage)
+	requestMu      sync.Mutex                     // Protects request
+	agreedProtocol atomic.Pointer[clientProtocol] // Set by hello (nil until then - see Client.protocol)
+	tasks          clientTasks                    // Work other goroutines queued for this client (see Client.Queue)
+	registered     chan struct{}                  // Closed by handleRegister (see Client.registration)
+	registeredOnce sync.Once
 	rateLimit      *clientRateLimit
 	rateLimitOnce  sync.Once
 	IP             string // Client's IP address (for connection limiting)
@@ -116,6 +126,8 @@ func (c *Client) SafeSend(message []byte) bool {
 		}
 	}()
 
+	message = c.answerRequest(message)
+
 	select {
 	case c.Send <- message:
 		return true
@@ -132,12 +144,21 @@ func (c *Client) ReadPump() {
 		if c.Cancel != nil {
 			c.Cancel()
 		}
+		c.closeQueue()
 		c.Hub.Unregister <- c
 		c.Conn.Close()
 	}()
 
+	// Registration settles the client's ID and queues a session resume, which runs before anything is read
+	select {
+	case <-c.registration():
+	case <-c.Ctx.Done():
+		return
+	}
+	c.runQueued()
+
 	if !c.CheckRateLimit() {
-		errorMsg, _ := types.NewErrorMessage("Rate limit exceeded")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRateLimited, "Rate limit exceeded")
 		c.SafeSend(errorMsg)
 		return
 	}
@@ -149,32 +170,59 @@ func (c *Client) ReadPump() {
 		return nil
 	})
 
+	// Messages are read on their own goroutine, so queued tasks run without waiting for the next one
+	messages := make(chan []byte)
+	go c.readMessages(messages)
+
 	for {
-		// Check context before blocking read
 		select {
 		case <-c.Ctx.Done():
 			return
-		default:
+
+		case <-c.taskQueued():
+			c.runQueued()
+
+		case messageBytes, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var msg types.Message
+			if err := json.Unmarshal(messageBytes, &msg); err != nil {
+				log.Printf("Error parsing message: %v", err)
+				errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid message format")
+				c.SafeSend(errorMsg)
+				continue
+			}
+
+			// Changes other goroutines made to this client take effect before its next message
+			c.runQueued()
+
+			if c.MessageHandler != nil {
+				c.handleMessage(&msg)
+			}
 		}
+	}
+}
+
+// readMessages reads messages from the connection and hands them to the ReadPump
+// Closes messages once the connection fails (the ReadPump closing it on exit ends the read)
+func (c *Client) readMessages(messages chan<- []byte) {
+	defer close(messages)
 
+	for {
 		_, messageBytes, err := c.Conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("error: %v", err)
 			}
-			break
-		}
-
-		var msg types.Message
-		if err := json.Unmarshal(messageBytes, &msg); err != nil {
-			log.Printf("Error parsing message: %v", err)
-			errorMsg, _ := types.NewErrorMessage("Invalid message format")
-			c.SafeSend(errorMsg)
-			continue
+			return
 		}
 
-		if c.MessageHandler != nil {
-			c.MessageHandler(c.Hub, c, &msg)
+		select {
+		case messages <- messageBytes:
+		case <-c.Ctx.Done():
+			return
 		}
 	}
 }
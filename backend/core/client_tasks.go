@@ -0,0 +1,71 @@
+package core
+
+import "sync"
+
+// clientTasks holds work queued for a client's own goroutine (see Client.Queue)
+type clientTasks struct {
+	mu     sync.Mutex
+	tasks  []func(*Client)
+	closed bool // The client's ReadPump has exited, so nothing runs queued tasks any more
+	queued chan struct{}
+	once   sync.Once
+}
+
+// Queue runs a task on the client's ReadPump goroutine as soon as it is free (thread-safe)
+// A client's room state (RoomID, Spectator, WaitingRoomID) is only touched by its own goroutine,
+// so other goroutines that move the client (kicks, waitlist admission) queue the change instead
+// Returns false if the client has disconnected and the task will never run
+func (c *Client) Queue(task func(*Client)) bool {
+	c.tasks.mu.Lock()
+	defer c.tasks.mu.Unlock()
+
+	if c.tasks.closed {
+		return false
+	}
+	c.tasks.tasks = append(c.tasks.tasks, task)
+
+	// Wake the ReadPump if it is waiting for a message
+	select {
+	case c.taskQueued() <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// taskQueued returns a channel that is signalled when a task is queued (see ReadPump)
+func (c *Client) taskQueued() chan struct{} {
+	c.tasks.once.Do(func() {
+		c.tasks.queued = make(chan struct{}, 1)
+	})
+	return c.tasks.queued
+}
+
+// runQueued runs the tasks queued for the client, oldest first
+// MUST be called on the client's ReadPump goroutine
+func (c *Client) runQueued() {
+	for {
+		c.tasks.mu.Lock()
+		tasks := c.tasks.tasks
+		c.tasks.tasks = nil
+		c.tasks.mu.Unlock()
+
+		if len(tasks) == 0 {
+			return
+		}
+		for _, task := range tasks {
+			task(c)
+		}
+	}
+}
+
+// closeQueue runs the remaining tasks and stops accepting new ones
+// Called as the ReadPump exits, so the hub sees the client's final state when it unregisters
+func (c *Client) closeQueue() {
+	c.runQueued()
+
+	c.tasks.mu.Lock()
+	defer c.tasks.mu.Unlock()
+	c.tasks.closed = true
+	// A task queued between the last run and closing is dropped, like one queued after
+	c.tasks.tasks = nil
+}
@@ -0,0 +1,54 @@
+package core
+
+import "testing"
+
+func TestClientTasks(t *testing.T) {
+	t.Run("RunsInOrder", func(t *testing.T) {
+		client := &Client{ClientID: "client-1"}
+		var order []string
+		client.Queue(func(c *Client) { order = append(order, "first") })
+		client.Queue(func(c *Client) {
+			order = append(order, "second")
+			c.Queue(func(c *Client) { order = append(order, "queued by a task") })
+		})
+
+		client.runQueued()
+		if len(order) != 3 || order[0] != "first" || order[1] != "second" || order[2] != "queued by a task" {
+			t.Errorf("Expected tasks in queue order, got %v", order)
+		}
+	})
+
+	t.Run("CloseRunsRemainingAndRejectsNew", func(t *testing.T) {
+		client := &Client{ClientID: "client-1", RoomID: "ROOM123"}
+		client.Queue(func(c *Client) { c.RoomID = "" })
+
+		client.closeQueue()
+		if client.RoomID != "" {
+			t.Error("Expected the queued task to run on close")
+		}
+		if client.Queue(func(c *Client) { c.RoomID = "ROOM123" }) {
+			t.Error("Expected Queue to refuse tasks after close")
+		}
+		client.runQueued()
+		if client.RoomID != "" {
+			t.Error("Expected a task queued after close never to run")
+		}
+	})
+
+	t.Run("QueueWakesReadPump", func(t *testing.T) {
+		client := &Client{ClientID: "client-1"}
+		client.Queue(func(c *Client) {})
+		client.Queue(func(c *Client) {})
+
+		select {
+		case <-client.taskQueued():
+		default:
+			t.Fatal("Expected queueing a task to signal the ReadPump")
+		}
+		select {
+		case <-client.taskQueued():
+			t.Error("Expected one signal for tasks queued together")
+		default:
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxDiceNotationLength caps the length of a dice notation string
+	MaxDiceNotationLength = 32
+	// MaxDiceCount caps the number of dice in one roll
+	MaxDiceCount = 100
+	// MaxDiceSides caps the number of sides on a die
+	MaxDiceSides = 1000
+	// MaxDiceModifier caps the absolute value of the modifier added to a roll
+	MaxDiceModifier = 1000
+)
+
+// DiceSpec is a parsed dice notation such as 2d6, 1d20+3 or 4d6kh3
+type DiceSpec struct {
+	Count      int  // Number of dice rolled
+	Sides      int  // Sides on each die
+	Keep       int  // Number of dice that count towards the total (equal to Count unless kh/kl is used)
+	KeepLowest bool // Keep the lowest dice instead of the highest
+	Modifier   int  // Added to the total of the kept dice
+}
+
+// ParseDice parses standard dice notation: [count]d<sides>[kh<n>|kl<n>][+<mod>|-<mod>]
+// The count defaults to 1; matching is case-insensitive and spaces are ignored
+func ParseDice(notation string) (DiceSpec, error) {
+	invalid := errors.New("Invalid dice notation")
+	if len(notation) > MaxDiceNotationLength {
+		return DiceSpec{}, invalid
+	}
+	s := strings.ToLower(strings.ReplaceAll(notation, " ", ""))
+
+	count, s := leadingNumber(s)
+	if count == "" {
+		count = "1"
+	}
+	if !strings.HasPrefix(s, "d") {
+		return DiceSpec{}, invalid
+	}
+	sides, s := leadingNumber(s[1:])
+
+	spec := DiceSpec{}
+	var err error
+	if spec.Count, err = strconv.Atoi(count); err != nil {
+		return DiceSpec{}, invalid
+	}
+	if spec.Sides, err = strconv.Atoi(sides); err != nil {
+		return DiceSpec{}, invalid
+	}
+	spec.Keep = spec.Count
+
+	if strings.HasPrefix(s, "kh") || strings.HasPrefix(s, "kl") {
+		spec.KeepLowest = s[1] == 'l'
+		var keep string
+		keep, s = leadingNumber(s[2:])
+		if spec.Keep, err = strconv.Atoi(keep); err != nil {
+			return DiceSpec{}, invalid
+		}
+	}
+
+	if s != "" {
+		if s[0] != '+' && s[0] != '-' {
+			return DiceSpec{}, invalid
+		}
+		modifier, rest := leadingNumber(s[1:])
+		if rest != "" {
+			return DiceSpec{}, invalid
+		}
+		if spec.Modifier, err = strconv.Atoi(modifier); err != nil {
+			return DiceSpec{}, invalid
+		}
+		if s[0] == '-' {
+			spec.Modifier = -spec.Modifier
+		}
+	}
+
+	if spec.Count < 1 || spec.Count > MaxDiceCount || spec.Sides < 2 || spec.Sides > MaxDiceSides ||
+		spec.Keep < 1 || spec.Keep > spec.Count || spec.Modifier > MaxDiceModifier || spec.Modifier < -MaxDiceModifier {
+		return DiceSpec{}, errors.New("Dice out of range")
+	}
+	return spec, nil
+}
+
+// leadingNumber splits s into its leading decimal digits and the rest
+func leadingNumber(s string) (string, string) {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// Roll rolls the dice with crypto/rand
+// Returns every die in the order rolled, the indexes of the dice left out of the total
+// (nil unless kh/kl is used) and the total
+func (s DiceSpec) Roll() ([]int, []int, int, error) {
+	dice := make([]int, s.Count)
+	for i := range dice {
+		n, err := randomIndex(s.Sides)
+		if err != nil {
+			// No fallback - a roll nobody can trust is worse than no roll
+			return nil, nil, 0, errors.New("Could not roll dice")
+		}
+		dice[i] = n + 1
+	}
+
+	// Order the dice from best to worst for the keep rule, breaking ties by roll order
+	order := make([]int, len(dice))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if s.KeepLowest {
+			return dice[order[a]] < dice[order[b]]
+		}
+		return dice[order[a]] > dice[order[b]]
+	})
+
+	total := s.Modifier
+	for _, i := range order[:s.Keep] {
+		total += dice[i]
+	}
+	var dropped []int
+	if s.Keep < s.Count {
+		dropped = append(dropped, order[s.Keep:]...)
+		sort.Ints(dropped)
+	}
+	return dice, dropped, total, nil
+}
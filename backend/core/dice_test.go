@@ -0,0 +1,96 @@
+package core
+
+import "testing"
+
+func TestParseDice(t *testing.T) {
+	tests := []struct {
+		notation string
+		want     DiceSpec
+		wantErr  bool
+	}{
+		{"2d6", DiceSpec{Count: 2, Sides: 6, Keep: 2}, false},
+		{"d20", DiceSpec{Count: 1, Sides: 20, Keep: 1}, false},
+		{"1d20+3", DiceSpec{Count: 1, Sides: 20, Keep: 1, Modifier: 3}, false},
+		{"1D8 - 2", DiceSpec{Count: 1, Sides: 8, Keep: 1, Modifier: -2}, false},
+		{"4d6kh3", DiceSpec{Count: 4, Sides: 6, Keep: 3}, false},
+		{"2d20kl1+5", DiceSpec{Count: 2, Sides: 20, Keep: 1, KeepLowest: true, Modifier: 5}, false},
+		{"", DiceSpec{}, true},
+		{"6", DiceSpec{}, true},
+		{"2d", DiceSpec{}, true},
+		{"2d6+", DiceSpec{}, true},
+		{"2d6x", DiceSpec{}, true},
+		{"2d6kh", DiceSpec{}, true},
+		{"2d6+1+1", DiceSpec{}, true},
+		{"0d6", DiceSpec{}, true},
+		{"1d1", DiceSpec{}, true},
+		{"101d6", DiceSpec{}, true},
+		{"1d1001", DiceSpec{}, true},
+		{"2d6kh3", DiceSpec{}, true},
+		{"1d6+1001", DiceSpec{}, true},
+		{"99999999999999999999d6", DiceSpec{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			got, err := ParseDice(tt.notation)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDice(%q) error = %v, wantErr %v", tt.notation, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseDice(%q) = %+v, want %+v", tt.notation, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiceRoll(t *testing.T) {
+	t.Run("InRange", func(t *testing.T) {
+		spec := DiceSpec{Count: 50, Sides: 6, Keep: 50, Modifier: 2}
+		dice, dropped, total, err := spec.Roll()
+		if err != nil {
+			t.Fatalf("Roll failed: %v", err)
+		}
+		sum := spec.Modifier
+		for _, die := range dice {
+			if die < 1 || die > 6 {
+				t.Errorf("Die out of range: %d", die)
+			}
+			sum += die
+		}
+		if len(dice) != 50 || dropped != nil || total != sum {
+			t.Errorf("Expected 50 dice totalling %d with none dropped, got %d dice, %v dropped, total %d", sum, len(dice), dropped, total)
+		}
+	})
+
+	t.Run("KeepHighest", func(t *testing.T) {
+		spec := DiceSpec{Count: 4, Sides: 6, Keep: 3}
+		for i := 0; i < 20; i++ {
+			dice, dropped, total, _ := spec.Roll()
+			if len(dropped) != 1 {
+				t.Fatalf("Expected one dropped die, got %v", dropped)
+			}
+			sum := 0
+			for j, die := range dice {
+				if j != dropped[0] {
+					sum += die
+					if die < dice[dropped[0]] {
+						t.Errorf("Dropped %d but kept lower die %d in %v", dice[dropped[0]], die, dice)
+					}
+				}
+			}
+			if total != sum {
+				t.Errorf("Expected total %d, got %d for %v", sum, total, dice)
+			}
+		}
+	})
+
+	t.Run("KeepLowest", func(t *testing.T) {
+		spec := DiceSpec{Count: 2, Sides: 20, Keep: 1, KeepLowest: true}
+		for i := 0; i < 20; i++ {
+			dice, _, total, _ := spec.Roll()
+			if total != min(dice[0], dice[1]) {
+				t.Errorf("Expected the lower of %v, got %d", dice, total)
+			}
+		}
+	})
+}
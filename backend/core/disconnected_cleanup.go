@@ -13,6 +13,7 @@ const (
 )
 
 // StartDisconnectedCleanup starts a background goroutine to clean up expired disconnected clients
+// It also drops expired wrong-PIN records
 func (h *Hub) StartDisconnectedCleanup() {
 	h.cleanupDone.Add(1)
 	go func() {
@@ -27,6 +28,7 @@ func (h *Hub) StartDisconnectedCleanup() {
 				return
 			case <-ticker.C:
 				h.cleanupDisconnectedClients()
+				h.prunePINFailures()
 			}
 		}
 	}()
@@ -36,12 +38,14 @@ func (h *Hub) StartDisconnectedCleanup() {
 func (h *Hub) cleanupDisconnectedClients() {
 	now := time.Now()
 	clientsToDelete := make([]string, 0)
+	lastRooms := make(map[string]string)
 
 	h.disconnectedMu.RLock()
 	// Collect expired client IDs
 	for clientID, client := range h.disconnectedClients {
 		if now.Sub(client.DisconnectedAt) > DisconnectedClientTTL {
 			clientsToDelete = append(clientsToDelete, clientID)
+			lastRooms[clientID] = client.LastRoomID
 		}
 	}
 	h.disconnectedMu.RUnlock()
@@ -58,6 +62,13 @@ func (h *Hub) cleanupDisconnectedClients() {
 	}
 	h.disconnectedMu.Unlock()
 
+	// Hosts who did not come back in time hand the role on
+	for _, clientID := range clientsToDelete {
+		if room := h.GetRoom(lastRooms[clientID]); room != nil {
+			h.promoteHost(room, clientID)
+		}
+	}
+
 	// Log outside of lock to minimize lock time
 	if len(clientsToDelete) > 0 {
 		for _, clientID := range clientsToDelete {
@@ -22,6 +22,8 @@ type DisconnectedClient struct {
 	Color          string
 	TotalTurnTime  int64
 	LastRoomID     string
+	Spectator      bool   // Whether the client was watching LastRoomID rather than seated
+	ResumeToken    string // Token the client must present to reclaim this data (see checkResumeToken)
 	DisconnectedAt time.Time
 }
 
@@ -30,21 +32,54 @@ type Hub struct {
 	rooms map[string]*Room
 	// Registered clients.
 	clients map[*Client]bool
+	// Connections waiting to take over a registered client's ID, keyed by the connection they replace (see takeOver)
+	takeovers map[*Client]*Client
 	// Register requests from the clients.
 	Register chan *Client
 	// Unregister requests from clients.
 	Unregister chan *Client
 	// OnPlayerLeft callback for when a player leaves
 	OnPlayerLeft func(roomID, clientID string, message []byte)
+	// OnHostChanged callback for when the host role passes to another player automatically
+	OnHostChanged func(roomID, hostID, previousHostID string)
+	// OnSpectatorsChanged callback for when a spectator leaves
+	OnSpectatorsChanged func(roomID string)
+	// OnWaitlistAdmitted callback for when a waitlisted client is given a free seat
+	OnWaitlistAdmitted func(roomID string, client *Client)
+	// OnWaitlistChanged callback for when the clients still on a room's waitlist move up
+	OnWaitlistChanged func(roomID string)
+	// OnSessionResumed callback for when a reconnecting client should be put back into its last room
+	// The room existed when the client reconnected
+	OnSessionResumed func(roomID string, client *Client, spectator bool)
+	// OnPresenceChanged callback for when a seated player goes away, or is removed after staying away too long
+	OnPresenceChanged func(roomID, clientID, presence string)
 	// OnTurnEnded callback for when a turn ends (due to disconnect)
 	OnTurnEnded func(roomID string)
+	// OnTurnWarning callback for when the active turn is close to its time limit
+	OnTurnWarning func(roomID, clientID string, remainingMs int64)
+	// OnTurnExpired callback for when the active turn reaches its time limit
+	// The room's expiry policy has already been applied when this is called
+	OnTurnExpired func(roomID, clientID, policy string)
+	// OnClockFlagged callback for when the active player's chess clock runs out
+	OnClockFlagged func(roomID, clientID string)
 	// Current connection count (atomic)
 	currentConnections int32
 	// Disconnected clients (for reconnection)
 	disconnectedClients map[string]*DisconnectedClient
 	disconnectedMu      sync.RWMutex // Protects disconnectedClients map
+	resumeTokenKey      []byte       // Signs resume tokens
 	ipConnections       map[string]int32
 	ipMu                sync.RWMutex
+	// Recent wrong room PINs, keyed by IP and room ID (see pinFailureKey)
+	pinFailures *failureThrottle
+	// Turn time limit timers, keyed by room ID
+	turnTimers   map[string]*turnTimer
+	turnTimersMu sync.Mutex
+	// Grace timers for away players, keyed by client ID
+	awayTimers      map[string]*awayTimer
+	awayTimersMu    sync.Mutex // Also protects the grace periods
+	awayTurnGrace   time.Duration
+	awayRemoveGrace time.Duration
 	// Shutdown coordination
 	shutdownCtx    context.Context
 	shutdownCancel context.CancelFunc
@@ -57,11 +92,18 @@ func NewHub() *Hub {
 	return &Hub{
 		rooms:               make(map[string]*Room),
 		clients:             make(map[*Client]bool),
+		takeovers:           make(map[*Client]*Client),
 		disconnectedClients: make(map[string]*DisconnectedClient),
+		resumeTokenKey:      newResumeTokenKey(),
 		Register:            make(chan *Client, 100), // Buffered to prevent blocking
 		Unregister:          make(chan *Client, 100), // Buffered to prevent blocking
 		currentConnections:  0,
 		ipConnections:       make(map[string]int32),
+		pinFailures:         newFailureThrottle(MaxPINFailuresPerRoom, PINFailureWindow),
+		turnTimers:          make(map[string]*turnTimer),
+		awayTimers:          make(map[string]*awayTimer),
+		awayTurnGrace:       AwayTurnGracePeriod,
+		awayRemoveGrace:     AwayRemoveGracePeriod,
 		shutdownCtx:         ctx,
 		shutdownCancel:      cancel,
 	}
@@ -99,6 +141,7 @@ func (h *Hub) Run() {
 
 		case client := <-h.Unregister:
 			h.handleUnregister(client)
+			h.completeTakeover(client)
 		}
 	}
 }
@@ -109,6 +152,8 @@ func (h *Hub) Shutdown() {
 
 	// Signal all goroutines to stop
 	h.shutdownCancel()
+	h.stopAllTurnTimers()
+	h.stopAllAwayTimers()
 
 	// Close all client connections
 	h.mu.RLock()
@@ -246,24 +291,86 @@ func (h *Hub) RemoveClientFromRoom(roomID, clientID, reason string) {
 	}
 
 	// Remove client from room (handles turn cleanup internally)
-	hadCurrentTurn, isEmpty := room.RemoveClient(clientID)
+	result := removal{
+		spectator: room.IsSpectator(clientID),
+		away:      room.IsAway(clientID),
+	}
+	result.hadCurrentTurn, result.isEmpty = room.RemoveClient(clientID)
+	h.clientRemoved(room, clientID, reason, result)
+}
+
+// removal describes a client that was just removed from a room
+type removal struct {
+	spectator      bool // The client was watching, not playing
+	away           bool // The player's connection had dropped (announced as gone rather than left)
+	hadCurrentTurn bool // The player had the turn, or a simultaneous phase was waiting on them
+	isEmpty        bool // Nobody is left in the room
+}
+
+// clientRemoved sends the notifications for a client that was removed from a room
+func (h *Hub) clientRemoved(room *Room, clientID, reason string, result removal) {
+	roomID := room.ID
+	if result.away {
+		h.stopAwayTimers(clientID)
+	}
 
 	// If client had current turn, notify that turn ended (even if room becomes empty)
-	if hadCurrentTurn && h.OnTurnEnded != nil {
+	if result.hadCurrentTurn && h.OnTurnEnded != nil {
 		h.OnTurnEnded(roomID)
 	}
 
-	if isEmpty {
-		log.Printf("Room %s is now empty (will be cleaned up by scheduled task)", roomID)
+	// A host who only disconnected keeps the role until their reconnect window runs out (see cleanupDisconnectedClients)
+	// Once an away player loses their seat, that window is over
+	if result.away || !h.isDisconnected(clientID) {
+		h.promoteHost(room, clientID)
+	}
+
+	if result.isEmpty {
+		// A waitlisted client takes the freed seat, otherwise the room is left for cleanup
+		if h.admitWaitlisted(room) == 0 {
+			log.Printf("Room %s is now empty (will be cleaned up by scheduled task)", roomID)
+		}
 		return
 	}
 
-	// Room is not empty - notify other players that this player left
-	if h.OnPlayerLeft != nil {
+	// Room is not empty - notify others that this player left (spectators only change the count)
+	if result.spectator {
+		if h.OnSpectatorsChanged != nil {
+			h.OnSpectatorsChanged(roomID)
+		}
+	} else if result.away {
+		if h.OnPresenceChanged != nil {
+			h.OnPresenceChanged(roomID, clientID, PresenceGone)
+		}
+	} else if h.OnPlayerLeft != nil {
 		h.OnPlayerLeft(roomID, clientID, nil)
 	}
 
 	if reason != "" {
 		log.Printf("Client %s removed from room %s: %s", clientID, roomID, reason)
 	}
+
+	// A freed seat goes to the first waitlisted client (after the others heard who left)
+	h.admitWaitlisted(room)
+}
+
+// promoteHost hands the host role to another player if previousHostID was the host and is no longer in the room
+func (h *Hub) promoteHost(room *Room, previousHostID string) {
+	hostID, ok := room.PromoteHost(previousHostID)
+	if !ok {
+		return
+	}
+
+	log.Printf("Host of room %s passed from %s to %s", room.ID, previousHostID, hostID)
+	if h.OnHostChanged != nil {
+		h.OnHostChanged(room.ID, hostID, previousHostID)
+	}
+}
+
+// isDisconnected reports whether the client disconnected recently and may still reconnect
+func (h *Hub) isDisconnected(clientID string) bool {
+	h.disconnectedMu.RLock()
+	defer h.disconnectedMu.RUnlock()
+	_, exists := h.disconnectedClients[clientID]
+	return exists
 }
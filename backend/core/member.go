@@ -0,0 +1,31 @@
+package core
+
+// Member holds per-seat state that belongs to the room rather than to a connection
+// Members are kept when their client leaves, so the state survives reconnects
+type Member struct {
+	ClientID    string
+	TimeBankMs  int64            // Remaining chess clock time (in milliseconds)
+	Flagged     bool             // Chess clock time bank ran out
+	RoundTurns  int              // Turns started in the current round
+	RoundTimeMs int64            // Time spent in turns in the current round (in milliseconds)
+	Passed      bool             // Passed for the rest of the current round
+	TurnTimesMs []int64          // Length of every finished turn in the game, oldest first (in milliseconds)
+	TeamID      string           // Team the player is on (empty if none)
+	Score       int64            // Current score (see ChangeScore)
+	AwaySince   int64            // Unix timestamp in nanoseconds when the player's connection dropped (0 while connected)
+	Counters    map[string]int64 // Counter values keyed by counter name (missing until first changed - see ChangeCounter)
+}
+
+// memberLocked returns the member record for a client, creating it if needed
+// MUST be called with r.mu.Lock() held
+func (r *Room) memberLocked(clientID string) *Member {
+	member := r.members[clientID]
+	if member == nil {
+		member = &Member{
+			ClientID:   clientID,
+			TimeBankMs: r.settings.ClockBankMs,
+		}
+		r.members[clientID] = member
+	}
+	return member
+}
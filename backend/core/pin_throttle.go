@@ -0,0 +1,106 @@
+package core
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// PINFailureWindow is how long a wrong PIN counts against the IP
+	PINFailureWindow = 5 * time.Minute
+	// MaxPINFailuresPerRoom limits wrong PINs from one IP for one room per window
+	// The count is kept per IP and room, so guessers cannot lock other newcomers out of the room,
+	// and players sharing a network address (a venue's Wi-Fi) are not locked out of every other room
+	MaxPINFailuresPerRoom = 5
+)
+
+// failureThrottle counts recent failures per key and blocks a key once it reaches the limit
+type failureThrottle struct {
+	mu       sync.Mutex
+	limit    int
+	window   time.Duration
+	failures map[string][]time.Time // Failure times per key, oldest first
+}
+
+func newFailureThrottle(limit int, window time.Duration) *failureThrottle {
+	return &failureThrottle{
+		limit:    limit,
+		window:   window,
+		failures: make(map[string][]time.Time),
+	}
+}
+
+// blocked reports whether the key has reached its failure limit
+func (t *failureThrottle) blocked(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.recentLocked(key, time.Now())) >= t.limit
+}
+
+// record counts a failure against the key
+func (t *failureThrottle) record(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	t.failures[key] = append(t.recentLocked(key, now), now)
+}
+
+// prune drops keys whose failures have all expired
+func (t *failureThrottle) prune() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	for key := range t.failures {
+		t.recentLocked(key, now)
+	}
+}
+
+// recentLocked drops expired failures for the key and returns the rest
+// MUST be called with t.mu held
+func (t *failureThrottle) recentLocked(key string, now time.Time) []time.Time {
+	failures := t.failures[key]
+	windowStart := now.Add(-t.window)
+	i := 0
+	for i < len(failures) && !failures[i].After(windowStart) {
+		i++
+	}
+	if i == len(failures) {
+		delete(t.failures, key)
+		return nil
+	}
+	failures = failures[i:]
+	t.failures[key] = failures
+	return failures
+}
+
+// CheckRoomPIN checks a join request against the room's PIN (thread-safe)
+// Wrong PINs are throttled per IP for the room; clients admitted before are not asked again
+// Returns an error if the client may not join
+func (h *Hub) CheckRoomPIN(room *Room, client *Client, pin string) error {
+	if !room.NeedsPIN(client.ClientID) {
+		return nil
+	}
+	key := pinFailureKey(client.IP, room.ID)
+	if h.pinFailures.blocked(key) {
+		return errors.New("Too many wrong PINs, try again later")
+	}
+	if pin == "" {
+		return errors.New("This room requires a PIN")
+	}
+	if !room.AdmitWithPIN(client.ClientID, pin) {
+		h.pinFailures.record(key)
+		return errors.New("Wrong PIN")
+	}
+	return nil
+}
+
+// pinFailureKey keys an IP's wrong PINs for one room
+func pinFailureKey(ip, roomID string) string {
+	return ip + "|" + roomID
+}
+
+// prunePINFailures drops expired wrong-PIN records
+func (h *Hub) prunePINFailures() {
+	h.pinFailures.prune()
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"log"
+	"time"
+)
+
+const (
+	// AwayTurnGracePeriod is how long an away player keeps their turn before it ends
+	AwayTurnGracePeriod = 30 * time.Second
+	// AwayRemoveGracePeriod is how long an away player keeps their seat before they are removed
+	AwayRemoveGracePeriod = DisconnectedClientTTL
+)
+
+// awayTimer holds the grace timers for an away player
+type awayTimer struct {
+	turn   *time.Timer
+	remove *time.Timer
+}
+
+// stop stops both timers
+func (t *awayTimer) stop() {
+	t.turn.Stop()
+	t.remove.Stop()
+}
+
+// SetAwayGracePeriods changes how long away players keep their turn and their seat
+// Only affects players who go away afterwards
+func (h *Hub) SetAwayGracePeriods(turnGrace, removeGrace time.Duration) {
+	h.awayTimersMu.Lock()
+	defer h.awayTimersMu.Unlock()
+	h.awayTurnGrace = turnGrace
+	h.awayRemoveGrace = removeGrace
+}
+
+// markAway keeps a disconnecting player seated as away and starts their grace periods
+// Returns false if the client is not the connection seated in the room (spectators are removed instead)
+func (h *Hub) markAway(room *Room, client *Client) bool {
+	since, phaseEnded, ok := room.MarkAway(client)
+	if !ok {
+		return false
+	}
+
+	clientID := client.ClientID
+	h.awayTimersMu.Lock()
+	if existing := h.awayTimers[clientID]; existing != nil {
+		existing.stop()
+	}
+	h.awayTimers[clientID] = &awayTimer{
+		turn: time.AfterFunc(h.awayTurnGrace, func() {
+			h.fireAwayTurnGrace(room, clientID, since)
+		}),
+		remove: time.AfterFunc(h.awayRemoveGrace, func() {
+			h.fireAwayRemove(room, clientID, since)
+		}),
+	}
+	h.awayTimersMu.Unlock()
+
+	if h.OnPresenceChanged != nil {
+		h.OnPresenceChanged(room.ID, clientID, PresenceAway)
+	}
+	if phaseEnded && h.OnTurnEnded != nil {
+		h.OnTurnEnded(room.ID)
+	}
+	return true
+}
+
+// Reconnect gives an away player's seat back to their new connection and stops their grace periods
+// The caller announces the return (presence_changed)
+// Returns false if the client is not away in the room
+func (h *Hub) Reconnect(room *Room, client *Client) bool {
+	if !room.Reconnect(client) {
+		return false
+	}
+	h.stopAwayTimers(client.ClientID)
+	log.Printf("Client %s is back in room %s", client.ClientID, room.ID)
+	return true
+}
+
+// stopAwayTimers stops the grace timers of a player who is no longer away
+func (h *Hub) stopAwayTimers(clientID string) {
+	h.awayTimersMu.Lock()
+	defer h.awayTimersMu.Unlock()
+
+	if existing := h.awayTimers[clientID]; existing != nil {
+		existing.stop()
+		delete(h.awayTimers, clientID)
+	}
+}
+
+// stopAllAwayTimers stops every grace timer (used during shutdown)
+func (h *Hub) stopAllAwayTimers() {
+	h.awayTimersMu.Lock()
+	defer h.awayTimersMu.Unlock()
+
+	for clientID, timers := range h.awayTimers {
+		timers.stop()
+		delete(h.awayTimers, clientID)
+	}
+}
+
+// fireAwayTurnGrace ends the turn of a player who stayed away too long
+func (h *Hub) fireAwayTurnGrace(room *Room, clientID string, since int64) {
+	if h.shutdownCtx.Err() != nil || !room.endAwayTurn(clientID, since) {
+		return // Shutting down, the player is back, or they had no turn
+	}
+
+	log.Printf("Turn of away client %s in room %s ended", clientID, room.ID)
+	if h.OnTurnEnded != nil {
+		h.OnTurnEnded(room.ID)
+	}
+}
+
+// fireAwayRemove removes a player who stayed away too long
+func (h *Hub) fireAwayRemove(room *Room, clientID string, since int64) {
+	if h.shutdownCtx.Err() != nil {
+		return
+	}
+
+	hadCurrentTurn, isEmpty, ok := room.removeAwayClient(clientID, since)
+	if !ok {
+		return // The player is back
+	}
+	h.clientRemoved(room, clientID, "away too long", removal{
+		away:           true,
+		hadCurrentTurn: hadCurrentTurn,
+		isEmpty:        isEmpty,
+	})
+}
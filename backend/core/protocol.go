@@ -0,0 +1,71 @@
+package core
+
+import "errors"
+
+const (
+	// ProtocolVersion is the newest protocol version the server speaks
+	ProtocolVersion = 2
+	// MinProtocolVersion is the oldest protocol version the server still speaks
+	MinProtocolVersion = 1
+	// LegacyProtocolVersion is assumed for clients that never send hello (deployed PWAs from before the handshake)
+	LegacyProtocolVersion = 1
+)
+
+// Optional protocol features - a client only gets a feature's messages and payloads if it names it in hello
+const (
+	FeaturePresence = "presence" // presence_changed messages (otherwise a removed away player is announced with player_left)
+)
+
+// ServerFeatures lists every optional feature the server supports, in the order it advertises them
+var ServerFeatures = []string{FeaturePresence}
+
+// clientProtocol is the features agreed with a client
+type clientProtocol struct {
+	features map[string]bool
+}
+
+// legacyProtocol is used for clients that have not sent hello
+var legacyProtocol = &clientProtocol{}
+
+// Negotiate agrees a protocol version and features with the client (thread-safe)
+// The client speaks the older of its version and the server's; features are those both sides support
+// Optional features came with protocol version 2, so a client speaking the legacy version gets none
+// Returns the agreed version and features, or an error if the client's version is too old
+func (c *Client) Negotiate(version int, features []string) (int, []string, error) {
+	if version < MinProtocolVersion {
+		return 0, nil, errors.New("Unsupported protocol version")
+	}
+	agreedVersion := min(version, ProtocolVersion)
+	if agreedVersion == LegacyProtocolVersion {
+		features = nil
+	}
+	agreed := &clientProtocol{features: make(map[string]bool)}
+
+	wanted := make(map[string]bool, len(features))
+	for _, feature := range features {
+		wanted[feature] = true
+	}
+	enabled := []string{}
+	for _, feature := range ServerFeatures {
+		if wanted[feature] {
+			agreed.features[feature] = true
+			enabled = append(enabled, feature)
+		}
+	}
+
+	c.agreedProtocol.Store(agreed)
+	return agreedVersion, enabled, nil
+}
+
+// Supports reports whether the client agreed to use an optional feature (thread-safe read)
+func (c *Client) Supports(feature string) bool {
+	return c.protocol().features[feature]
+}
+
+// protocol returns the protocol agreed with the client, or the legacy protocol before hello
+func (c *Client) protocol() *clientProtocol {
+	if agreed := c.agreedProtocol.Load(); agreed != nil {
+		return agreed
+	}
+	return legacyProtocol
+}
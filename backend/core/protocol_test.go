@@ -0,0 +1,76 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestProtocol(t *testing.T) {
+	t.Run("LegacyBeforeHello", func(t *testing.T) {
+		client := &Client{ClientID: "client-1"}
+
+		if client.Supports(FeaturePresence) {
+			t.Error("Expected no features before hello")
+		}
+	})
+
+	t.Run("Negotiate", func(t *testing.T) {
+		client := &Client{ClientID: "client-1"}
+
+		version, enabled, err := client.Negotiate(ProtocolVersion+1, []string{"unknown", FeaturePresence})
+		if err != nil {
+			t.Fatalf("Expected negotiation to succeed, got %v", err)
+		}
+		if version != ProtocolVersion {
+			t.Errorf("Expected version %d, got %d", ProtocolVersion, version)
+		}
+		// Features the server does not know are dropped
+		if len(enabled) != 1 || enabled[0] != FeaturePresence {
+			t.Errorf("Expected [presence], got %v", enabled)
+		}
+		if !client.Supports(FeaturePresence) || client.Supports("unknown") {
+			t.Error("Expected only the shared features to be supported")
+		}
+
+		// A second hello replaces the first, and the legacy version has no optional features
+		version, enabled, err = client.Negotiate(LegacyProtocolVersion, []string{FeaturePresence})
+		if err != nil {
+			t.Fatalf("Expected renegotiation to succeed, got %v", err)
+		}
+		if version != LegacyProtocolVersion || len(enabled) != 0 || client.Supports(FeaturePresence) {
+			t.Errorf("Expected renegotiation to the legacy version without features, got %d %v", version, enabled)
+		}
+	})
+
+	t.Run("RejectsOldVersion", func(t *testing.T) {
+		client := &Client{ClientID: "client-1"}
+
+		if _, _, err := client.Negotiate(MinProtocolVersion-1, nil); err == nil {
+			t.Error("Expected an error for a version older than the server speaks")
+		}
+	})
+
+	t.Run("BroadcastToRoomByFeature", func(t *testing.T) {
+		hub := NewHub()
+		room := NewRoom("ROOM123")
+		modern := &Client{ClientID: "client-1", Send: make(chan []byte, 4)}
+		legacy := &Client{ClientID: "client-2", Send: make(chan []byte, 4)}
+		modern.Negotiate(ProtocolVersion, []string{FeaturePresence})
+		room.Clients[modern.ClientID] = modern
+		room.Clients[legacy.ClientID] = legacy
+		hub.AddRoom(room.ID, room)
+
+		hub.BroadcastToRoomByFeature(room.ID, nil, FeaturePresence, []byte(`{"type":"new"}`), []byte(`{"type":"old"}`))
+		if got := string(<-modern.Send); got != `{"type":"new"}` {
+			t.Errorf("Expected the new payload for a client with the feature, got %s", got)
+		}
+		if got := string(<-legacy.Send); got != `{"type":"old"}` {
+			t.Errorf("Expected the old payload for a client without the feature, got %s", got)
+		}
+
+		// nil sends clients without the feature nothing
+		hub.BroadcastToRoomByFeature(room.ID, nil, FeaturePresence, []byte(`{"type":"new"}`), nil)
+		if len(modern.Send) != 1 || len(legacy.Send) != 0 {
+			t.Errorf("Expected only the client with the feature to get a message, got %d and %d", len(modern.Send), len(legacy.Send))
+		}
+	})
+}
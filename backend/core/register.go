@@ -6,13 +6,15 @@ import (
 
 // handleRegister handles client registration
 func (h *Hub) handleRegister(client *Client) {
+	var resumed *DisconnectedClient
+
 	// Check if this is a reconnection with existing clientID
 	if client.ClientID != "" {
 		h.disconnectedMu.RLock()
 		disconnected, exists := h.disconnectedClients[client.ClientID]
 		h.disconnectedMu.RUnlock()
 
-		if exists {
+		if exists && h.checkResumeToken(client.ClientID, disconnected.ResumeToken, client.ResumeToken) {
 			// Restore client data from disconnected clients map
 			client.DisplayName = disconnected.DisplayName
 			client.Color = disconnected.Color
@@ -21,18 +23,31 @@ func (h *Hub) handleRegister(client *Client) {
 			h.disconnectedMu.Lock()
 			delete(h.disconnectedClients, client.ClientID)
 			h.disconnectedMu.Unlock()
+			resumed = disconnected
 			log.Printf("Client reconnected: %s (restored data)", client.ClientID)
+		} else if h.takeOver(client) {
+			return // Registration finishes once the old connection has unregistered (see completeTakeover)
 		} else {
-			// New client with provided ID, validate format
+			// Client IDs are public (every peer sees them), so only a valid resume token reclaims one
 			if !isValidClientID(client.ClientID) {
-				client.ClientID = GenerateClientID()
-				log.Printf("Invalid clientID format, generated new: %s", client.ClientID)
+				log.Printf("Invalid clientID format, generating new")
+			} else {
+				log.Printf("Client %s did not present a valid resume token, generating new ID", client.ClientID)
 			}
+			client.ClientID = GenerateClientID()
 		}
 	} else {
 		// Generate new client ID
 		client.ClientID = GenerateClientID()
 	}
+	h.finishRegister(client, resumed)
+}
+
+// finishRegister registers a client whose ID is settled
+// resumed is the disconnected record the client reclaimed, if any
+func (h *Hub) finishRegister(client *Client, resumed *DisconnectedClient) {
+	// Every registration gets a fresh token, so a token can only be used once
+	client.ResumeToken = h.issueResumeToken(client.ClientID)
 	h.clients[client] = true
 
 	var remoteAddr string
@@ -42,7 +57,76 @@ func (h *Hub) handleRegister(client *Client) {
 		remoteAddr = "<no-connection>"
 	}
 	log.Printf("Client registered: %s (ID: %s)", remoteAddr, client.ClientID)
-	// Room assignment happens via create_room or join_room messages
+
+	// A reconnecting client goes back into its last room if it still exists (see OnSessionResumed)
+	// Otherwise room assignment happens via create_room or join_room messages
+	// The rejoin runs on the client's own goroutine before its first message, so it never holds up the hub
+	if resumed != nil && resumed.LastRoomID != "" && h.OnSessionResumed != nil && h.RoomExists(resumed.LastRoomID) {
+		roomID, spectator := resumed.LastRoomID, resumed.Spectator
+		client.Queue(func(c *Client) {
+			h.OnSessionResumed(roomID, c, spectator)
+		})
+	}
+	close(client.registration())
+}
+
+// takeOver starts handing a registered client's ID to a new connection of the same client
+// A phone that switches networks reconnects before its old connection times out, so its ID is not
+// in disconnectedClients yet; the token it holds is the one issued to the live connection
+// The old connection is closed, and the new one is registered after the old one has unregistered,
+// so the old connection's room state is only read once its goroutines have stopped
+// Returns false if the client's token does not reclaim a live connection
+func (h *Hub) takeOver(client *Client) bool {
+	var previous *Client
+	for registered := range h.clients {
+		if registered.ClientID == client.ClientID {
+			previous = registered
+			break
+		}
+	}
+	if previous == nil || h.takeovers[previous] != nil ||
+		!h.checkResumeToken(previous.ClientID, previous.ResumeToken, client.ResumeToken) {
+		return false
+	}
+
+	h.takeovers[previous] = client
+	if previous.Cancel != nil {
+		previous.Cancel()
+	}
+	if previous.Conn != nil {
+		previous.Conn.Close()
+	}
+	log.Printf("Client %s reconnected before its old connection closed, replacing it", client.ClientID)
+	return true
+}
+
+// completeTakeover registers the connection waiting to take over a client that just unregistered
+// The new connection gets the old one's profile, and its room back if the old one was in one
+func (h *Hub) completeTakeover(previous *Client) {
+	client := h.takeovers[previous]
+	if client == nil {
+		return
+	}
+	delete(h.takeovers, previous)
+
+	client.DisplayName = previous.DisplayName
+	client.Color = previous.Color
+	client.TotalTurnTime = previous.TotalTurnTime
+
+	h.disconnectedMu.Lock()
+	resumed := h.disconnectedClients[client.ClientID]
+	delete(h.disconnectedClients, client.ClientID)
+	h.disconnectedMu.Unlock()
+
+	h.finishRegister(client, resumed)
+}
+
+// registration returns a channel that is closed once the client is registered (see ReadPump)
+func (c *Client) registration() chan struct{} {
+	c.registeredOnce.Do(func() {
+		c.registered = make(chan struct{})
+	})
+	return c.registered
 }
 
 // isValidClientID validates that a clientID is exactly 16 hex characters
@@ -43,11 +43,14 @@ func TestRegister(t *testing.T) {
 		client := createTestClient(clientID)
 
 		// Add to disconnected clients
+		token := hub.issueResumeToken(clientID)
+		client.ResumeToken = token
 		disconnected := &DisconnectedClient{
 			ClientID:      clientID,
 			DisplayName:   "TestUser",
 			Color:         "#FF0000",
 			TotalTurnTime: 5000,
+			ResumeToken:   token,
 		}
 
 		hub.disconnectedMu.Lock()
@@ -73,6 +76,183 @@ func TestRegister(t *testing.T) {
 		if exists {
 			t.Error("Expected client to be removed from disconnectedClients on reconnect")
 		}
+		if client.ResumeToken == token || client.ResumeToken == "" {
+			t.Error("Expected the resume token to be rotated on reconnect")
+		}
+	})
+
+	t.Run("RequiresResumeTokenToReclaimClientID", func(t *testing.T) {
+		hub := NewHub()
+		clientID := "abc123def4567890"
+		token := hub.issueResumeToken(clientID)
+		hub.disconnectedMu.Lock()
+		hub.disconnectedClients[clientID] = &DisconnectedClient{
+			ClientID:    clientID,
+			DisplayName: "TestUser",
+			ResumeToken: token,
+		}
+		hub.disconnectedMu.Unlock()
+
+		otherToken := hub.issueResumeToken("1234567890abcdef")
+		forgedToken := token[:len(token)-4] + "AAAA"
+		for _, presented := range []string{"", "not-a-token", otherToken, forgedToken} {
+			client := createTestClient(clientID)
+			client.ResumeToken = presented
+
+			hub.handleRegister(client)
+
+			if client.ClientID == clientID {
+				t.Errorf("Expected token %q NOT to reclaim the client ID", presented)
+			}
+			if client.DisplayName != "" {
+				t.Errorf("Expected token %q NOT to restore the profile", presented)
+			}
+		}
+
+		// The disconnected client is still there for the real owner
+		client := createTestClient(clientID)
+		client.ResumeToken = token
+		hub.handleRegister(client)
+		if client.ClientID != clientID || client.DisplayName != "TestUser" {
+			t.Error("Expected the issued token to reclaim the client ID")
+		}
+	})
+
+	t.Run("TakesOverLiveConnection", func(t *testing.T) {
+		hub := NewHub()
+		previous := createTestClient("")
+		previous.DisplayName = "TestUser"
+		hub.handleRegister(previous)
+
+		client := createTestClient(previous.ClientID)
+		client.ResumeToken = previous.ResumeToken
+		hub.handleRegister(client)
+		if hub.clients[client] {
+			t.Fatal("Expected registration to wait for the old connection to unregister")
+		}
+
+		hub.handleUnregister(previous)
+		hub.completeTakeover(previous)
+
+		if !hub.clients[client] || client.ClientID != previous.ClientID || client.DisplayName != "TestUser" {
+			t.Errorf("Expected the new connection to take over %s, got %s (%q)", previous.ClientID, client.ClientID, client.DisplayName)
+		}
+		if client.ResumeToken == previous.ResumeToken {
+			t.Error("Expected a fresh resume token after the takeover")
+		}
+	})
+
+	t.Run("RequiresResumeTokenToTakeOverLiveConnection", func(t *testing.T) {
+		hub := NewHub()
+		previous := createTestClient("")
+		hub.handleRegister(previous)
+
+		client := createTestClient(previous.ClientID)
+		client.ResumeToken = hub.issueResumeToken(previous.ClientID) // Signed, but not the token the live connection holds
+		hub.handleRegister(client)
+
+		if !hub.clients[client] || client.ClientID == previous.ClientID {
+			t.Error("Expected a new client ID without the live connection's token")
+		}
+		if !hub.clients[previous] {
+			t.Error("Expected the live connection to be left alone")
+		}
+	})
+
+	t.Run("RejectsRotatedResumeToken", func(t *testing.T) {
+		hub := NewHub()
+		clientID := "abc123def4567890"
+		oldToken := hub.issueResumeToken(clientID)
+		hub.disconnectedMu.Lock()
+		hub.disconnectedClients[clientID] = &DisconnectedClient{
+			ClientID:    clientID,
+			DisplayName: "TestUser",
+			ResumeToken: hub.issueResumeToken(clientID),
+		}
+		hub.disconnectedMu.Unlock()
+
+		client := createTestClient(clientID)
+		client.ResumeToken = oldToken
+		hub.handleRegister(client)
+
+		if client.ClientID == clientID {
+			t.Error("Expected an earlier token for the same client NOT to reclaim the client ID")
+		}
+	})
+
+	t.Run("ResumesLastRoomOnReconnection", func(t *testing.T) {
+		hub := NewHub()
+		clientID := "abc123def4567890"
+		client := createTestClient(clientID)
+		client.ResumeToken = hub.issueResumeToken(clientID)
+		hub.AddRoom("ROOM123", NewRoom("ROOM123"))
+
+		hub.disconnectedMu.Lock()
+		hub.disconnectedClients[clientID] = &DisconnectedClient{
+			ClientID:    clientID,
+			DisplayName: "TestUser",
+			LastRoomID:  "ROOM123",
+			Spectator:   true,
+			ResumeToken: client.ResumeToken,
+		}
+		hub.disconnectedMu.Unlock()
+
+		var resumedRoomID string
+		var resumedSpectator bool
+		hub.OnSessionResumed = func(roomID string, c *Client, spectator bool) {
+			if c != client {
+				t.Error("Expected OnSessionResumed to be called with the reconnecting client")
+			}
+			resumedRoomID = roomID
+			resumedSpectator = spectator
+		}
+
+		hub.handleRegister(client)
+		if resumedRoomID != "" {
+			t.Error("Expected the session to be resumed on the client's goroutine, not during registration")
+		}
+		client.runQueued()
+
+		if resumedRoomID != "ROOM123" {
+			t.Errorf("Expected OnSessionResumed with 'ROOM123', got '%s'", resumedRoomID)
+		}
+		if !resumedSpectator {
+			t.Error("Expected OnSessionResumed to pass on that the client was a spectator")
+		}
+		if !hub.clients[client] {
+			t.Error("Expected client to be registered before its session is resumed")
+		}
+	})
+
+	t.Run("DoesNotResumeDeletedRoom", func(t *testing.T) {
+		hub := NewHub()
+		clientID := "abc123def4567890"
+		client := createTestClient(clientID)
+		client.ResumeToken = hub.issueResumeToken(clientID)
+
+		hub.disconnectedMu.Lock()
+		hub.disconnectedClients[clientID] = &DisconnectedClient{
+			ClientID:    clientID,
+			DisplayName: "TestUser",
+			LastRoomID:  "ROOM123",
+			ResumeToken: client.ResumeToken,
+		}
+		hub.disconnectedMu.Unlock()
+
+		resumeCalled := false
+		hub.OnSessionResumed = func(roomID string, c *Client, spectator bool) {
+			resumeCalled = true
+		}
+
+		hub.handleRegister(client)
+		client.runQueued()
+
+		if resumeCalled {
+			t.Error("Expected OnSessionResumed NOT to be called when the last room no longer exists")
+		}
+		if client.DisplayName != "TestUser" {
+			t.Errorf("Expected profile to be restored anyway, got '%s'", client.DisplayName)
+		}
 	})
 
 	t.Run("GeneratesNewIDForInvalidFormat", func(t *testing.T) {
@@ -120,27 +300,20 @@ func TestRegister(t *testing.T) {
 		}
 	})
 
-	t.Run("KeepsValidClientID", func(t *testing.T) {
-		hub := NewHub()
-		validID := "abc123def4567890" // 16 hex chars
-		client := createTestClient(validID)
-
-		hub.handleRegister(client)
-
-		if client.ClientID != validID {
-			t.Errorf("Expected valid clientID to be kept, got '%s'", client.ClientID)
-		}
-	})
-
-	t.Run("KeepsValidClientIDNotInDisconnected", func(t *testing.T) {
+	t.Run("ReplacesValidClientIDNotInDisconnected", func(t *testing.T) {
 		hub := NewHub()
 		validID := "1234567890abcdef" // 16 hex chars, not in disconnected
 		client := createTestClient(validID)
+		client.ResumeToken = hub.issueResumeToken(validID)
 
 		hub.handleRegister(client)
 
-		if client.ClientID != validID {
-			t.Errorf("Expected valid clientID to be kept when not in disconnected, got '%s'", client.ClientID)
+		// Client IDs are public, so a valid format alone is not enough to keep one
+		if client.ClientID == validID {
+			t.Error("Expected clientID with nothing to reclaim to be replaced")
+		}
+		if client.ResumeToken == "" {
+			t.Error("Expected a resume token to be issued")
 		}
 	})
 
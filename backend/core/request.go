@@ -0,0 +1,118 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+
+	"turn-tracker/backend/types"
+)
+
+// MaxRequestIDLength caps the length of the id a client may attach to a message
+const MaxRequestIDLength = 64
+
+// errorMessagePrefix starts every message built by types.NewErrorMessage and types.NewCodedErrorMessage
+var errorMessagePrefix = []byte(`{"type":"error"`)
+
+// clientRequest is the message a client's handler is working on
+type clientRequest struct {
+	id     string // Id chosen by the client (empty if it did not send one)
+	failed bool   // An error answered the request, so it is not acknowledged
+}
+
+// handleMessage runs the client's handler for a message it sent
+// A message with an id is answered with exactly one ack or error echoing that id
+func (c *Client) handleMessage(msg *types.Message) {
+	if len(msg.ID) > MaxRequestIDLength {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid request id")
+		c.SafeSend(errorMsg)
+		return
+	}
+
+	c.requestMu.Lock()
+	c.request = clientRequest{id: msg.ID}
+	c.requestMu.Unlock()
+
+	c.MessageHandler(c.Hub, c, msg)
+
+	c.requestMu.Lock()
+	request := c.request
+	c.request = clientRequest{}
+	c.requestMu.Unlock()
+
+	if request.id == "" || request.failed {
+		return
+	}
+	ackMsg, err := types.NewAckMessage(request.id, msg.Type)
+	if err != nil {
+		log.Printf("Error creating ack message: %v", err)
+		return
+	}
+	c.SafeSend(ackMsg)
+}
+
+// WithRequestID tags a message caused by the client's current request with the request's id
+// Returns the message unchanged if the request has no id (or c is nil, for changes nobody asked for)
+func (c *Client) WithRequestID(message []byte) []byte {
+	if c == nil {
+		return message
+	}
+	c.requestMu.Lock()
+	id := c.request.id
+	c.requestMu.Unlock()
+
+	if id == "" {
+		return message
+	}
+	return withRequestID(message, id)
+}
+
+// RejectRequest answers the client's current request with a coded error, if the request has an id
+// Used where a request changes nothing without being wrong (such as a stale start_turn), so clients
+// that send ids can tell it apart from an ack while older clients see no change in behaviour
+func (c *Client) RejectRequest(code, message string) {
+	c.requestMu.Lock()
+	id := c.request.id
+	c.requestMu.Unlock()
+
+	if id == "" {
+		return
+	}
+	errorMsg, _ := types.NewCodedErrorMessage(code, message)
+	c.SafeSend(errorMsg)
+}
+
+// answerRequest tags an error sent to the client with the id of the request it answers
+// Errors only ever go to the client whose message caused them
+func (c *Client) answerRequest(message []byte) []byte {
+	if !bytes.HasPrefix(message, errorMessagePrefix) {
+		return message
+	}
+	c.requestMu.Lock()
+	defer c.requestMu.Unlock()
+
+	if c.request.id == "" {
+		return message
+	}
+	c.request.failed = true
+	return withRequestID(message, c.request.id)
+}
+
+// withRequestID returns a copy of a message with an id added to its envelope
+func withRequestID(message []byte, id string) []byte {
+	if len(message) < 2 || message[0] != '{' {
+		return message
+	}
+	idJSON, err := json.Marshal(id)
+	if err != nil {
+		return message
+	}
+	buf := GetMessageBuffer()
+	buf = append(buf, `{"id":`...)
+	buf = append(buf, idJSON...)
+	if message[1] != '}' {
+		buf = append(buf, ',')
+	}
+	buf = append(buf, message[1:]...)
+	return buf
+}
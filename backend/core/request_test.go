@@ -0,0 +1,112 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"turn-tracker/backend/types"
+)
+
+func TestRequests(t *testing.T) {
+	newClient := func(handler MessageHandler) *Client {
+		return &Client{ClientID: "client-1", Send: make(chan []byte, 8), MessageHandler: handler}
+	}
+	receive := func(t *testing.T, client *Client) types.Message {
+		t.Helper()
+		select {
+		case raw := <-client.Send:
+			var msg types.Message
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				t.Fatalf("Expected valid JSON, got %q: %v", raw, err)
+			}
+			return msg
+		default:
+			t.Fatal("Expected a message")
+			return types.Message{}
+		}
+	}
+
+	t.Run("AcksHandledRequest", func(t *testing.T) {
+		client := newClient(func(hub *Hub, c *Client, msg *types.Message) {})
+		client.handleMessage(&types.Message{Type: "start_turn", ID: "req-1"})
+
+		msg := receive(t, client)
+		var ack types.AckData
+		json.Unmarshal(msg.Data, &ack)
+		if msg.Type != "ack" || msg.ID != "req-1" || ack.Type != "start_turn" {
+			t.Errorf("Expected ack of start_turn with id req-1, got %+v", msg)
+		}
+	})
+
+	t.Run("ErrorReplacesAck", func(t *testing.T) {
+		client := newClient(func(hub *Hub, c *Client, msg *types.Message) {
+			errorMsg, _ := types.NewErrorMessage("Room not found")
+			c.SafeSend(errorMsg)
+		})
+		client.handleMessage(&types.Message{Type: "join_room", ID: "req-1"})
+
+		msg := receive(t, client)
+		if msg.Type != "error" || msg.ID != "req-1" {
+			t.Errorf("Expected error with id req-1, got %+v", msg)
+		}
+		if len(client.Send) != 0 {
+			t.Error("Expected no ack after an error")
+		}
+	})
+
+	t.Run("NoAckWithoutID", func(t *testing.T) {
+		client := newClient(func(hub *Hub, c *Client, msg *types.Message) {
+			errorMsg, _ := types.NewErrorMessage("Room not found")
+			c.SafeSend(errorMsg)
+			c.RejectRequest(types.ErrorCodeNoChange, "Turn is already set")
+		})
+		client.handleMessage(&types.Message{Type: "join_room"})
+
+		if msg := receive(t, client); msg.ID != "" {
+			t.Errorf("Expected error without an id, got %+v", msg)
+		}
+		if len(client.Send) != 0 {
+			t.Error("Expected no ack, and no rejection, for a message without an id")
+		}
+	})
+
+	t.Run("RejectsLongID", func(t *testing.T) {
+		called := false
+		client := newClient(func(hub *Hub, c *Client, msg *types.Message) { called = true })
+		client.handleMessage(&types.Message{Type: "start_turn", ID: strings.Repeat("x", MaxRequestIDLength+1)})
+
+		if called {
+			t.Error("Expected the handler not to run")
+		}
+		if msg := receive(t, client); msg.Type != "error" {
+			t.Errorf("Expected error, got %+v", msg)
+		}
+	})
+
+	t.Run("WithRequestID", func(t *testing.T) {
+		var stamped []byte
+		client := newClient(func(hub *Hub, c *Client, msg *types.Message) {
+			stamped = c.WithRequestID([]byte(`{"type":"turn_changed","data":{}}`))
+		})
+		client.handleMessage(&types.Message{Type: "start_turn", ID: `a"b`})
+
+		var msg types.Message
+		if err := json.Unmarshal(stamped, &msg); err != nil {
+			t.Fatalf("Expected valid JSON, got %q: %v", stamped, err)
+		}
+		if msg.Type != "turn_changed" || msg.ID != `a"b` {
+			t.Errorf("Expected turn_changed with the request id, got %+v", msg)
+		}
+
+		// Outside a request, and without a client, messages are left alone
+		plain := []byte(`{"type":"turn_changed"}`)
+		if got := client.WithRequestID(plain); string(got) != string(plain) {
+			t.Errorf("Expected message unchanged after the request, got %q", got)
+		}
+		var nobody *Client
+		if got := nobody.WithRequestID(plain); string(got) != string(plain) {
+			t.Errorf("Expected message unchanged without a client, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+const (
+	// resumeTokenNonceSize is the number of random bytes that make every token unique
+	resumeTokenNonceSize = 16
+	// resumeTokenKeySize is the size of the hub's signing key
+	resumeTokenKeySize = 32
+)
+
+// newResumeTokenKey generates a signing key for resume tokens
+// Tokens are only valid for the life of the hub, just like the disconnected clients they unlock
+func newResumeTokenKey() []byte {
+	key := make([]byte, resumeTokenKeySize)
+	if _, err := rand.Read(key); err != nil {
+		// No fallback - a guessable key would let anyone take over another player's seat
+		panic("crypto/rand unavailable: " + err.Error())
+	}
+	return key
+}
+
+// issueResumeToken creates a new token that lets a client reclaim clientID after a disconnect
+// The token is a random nonce followed by an HMAC-SHA256 of the client ID and nonce, base64url encoded
+func (h *Hub) issueResumeToken(clientID string) string {
+	nonce := make([]byte, resumeTokenNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		panic("crypto/rand unavailable: " + err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(append(nonce, h.signResumeToken(clientID, nonce)...))
+}
+
+// checkResumeToken reports whether token reclaims clientID
+// The token must be signed for the client's ID and be the latest one issued to it (issued),
+// so a token stops working once the client has reconnected with it
+func (h *Hub) checkResumeToken(clientID, issued, token string) bool {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != resumeTokenNonceSize+sha256.Size {
+		return false
+	}
+	nonce, mac := raw[:resumeTokenNonceSize], raw[resumeTokenNonceSize:]
+	if !hmac.Equal(mac, h.signResumeToken(clientID, nonce)) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(issued)) == 1
+}
+
+// signResumeToken returns the HMAC of a client ID and token nonce
+func (h *Hub) signResumeToken(clientID string, nonce []byte) []byte {
+	mac := hmac.New(sha256.New, h.resumeTokenKey)
+	mac.Write(nonce)
+	mac.Write([]byte(clientID))
+	return mac.Sum(nil)
+}
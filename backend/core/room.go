@@ -6,30 +6,74 @@ import (
 )
 
 type PeerInfo struct {
-	ClientID      string `json:"client_id"`
-	DisplayName   string `json:"display_name"`
-	Color         string `json:"color"`
-	TotalTurnTime int64  `json:"total_turn_time"` // Total time spent in turns (in milliseconds)
+	ClientID      string           `json:"client_id"`
+	DisplayName   string           `json:"display_name"`
+	Color         string           `json:"color"`
+	TotalTurnTime int64            `json:"total_turn_time"`    // Total time spent in turns (in milliseconds)
+	Passed        bool             `json:"passed"`             // Passed for the rest of the round
+	TeamID        string           `json:"team_id,omitempty"`  // Team the player is on (empty if none)
+	Presence      string           `json:"presence"`           // connected or away (see PresenceConnected)
+	Score         int64            `json:"score"`              // Current score
+	Counters      map[string]int64 `json:"counters,omitempty"` // Every counter the room defines, keyed by counter name
 }
 
 type Room struct {
-	mu            sync.RWMutex // Protects all Room state
-	ID            string
-	Clients       map[string]*Client
-	CreatedBy     string    // clientID of the room creator
-	CreatedAt     time.Time // When the room was created (for cleanup)
-	CurrentTurn   string    // clientID of the player whose turn it is (empty if no turn active)
-	TurnStartTime *int64    // Unix timestamp in nanoseconds when current turn started (nil if no turn active)
-	turnSequence  uint64    // Sequence number for turn_changed messages (incremented on each turn change)
+	mu              sync.RWMutex // Protects all Room state
+	ID              string
+	Clients         map[string]*Client
+	CreatedBy       string    // clientID of the room creator
+	CreatedAt       time.Time // When the room was created (for cleanup)
+	CurrentTurn     string    // clientID of the player whose turn it is (empty if no turn active)
+	TurnStartTime   *int64    // Unix timestamp in nanoseconds when current turn started (nil if no turn active)
+	turnSequence    uint64    // Sequence number for turn_changed messages (incremented on each turn change)
+	seats           []string  // clientIDs in seating order (join order by default)
+	lastTurn        string    // clientID whose seat next_turn advances from when no turn is active
+	settings        RoomSettings
+	turnGen         uint64             // Incremented whenever the active turn starts or stops (used to discard stale timers)
+	members         map[string]*Member // Per-seat state that survives reconnects, keyed by clientID
+	pausedAt        *int64             // Unix timestamp in nanoseconds when the game was paused (nil if running)
+	pausedBy        string             // clientID that paused the game
+	round           int                // Current round number (starts at 1)
+	roundPlayers    []string           // clientIDs that started a turn this round, in order of their first turn
+	completedRounds []RoundSummary     // Completed rounds not yet announced (see TakeCompletedRounds)
+	phase           *phase             // Active simultaneous phase (nil if none)
+	completedPhase  *PhaseState        // Phase ended by a player leaving, not yet announced (see TakeCompletedPhase)
+	turnStartedAt   int64              // Wall-clock Unix timestamp in nanoseconds when the current turn started (not shifted by pauses)
+	history         []TurnRecord       // Finished turns, oldest first (capped at MaxTurnHistory)
+	historySeq      int                // Sequence number for the next history record
+	undo            []turnUndo         // Undoable turn changes, oldest first (capped at settings.UndoDepth)
+	ended           *GameSummary       // Summary of the ended game (nil while the game is running)
+	spectators      map[string]*Client // Read-only clients that receive broadcasts but have no seat, keyed by clientID
+	host            string             // clientID of the host (the first player to join, until the role is handed on)
+	bans            map[string]int64   // Kicked clientIDs and when they may rejoin (Unix timestamp in nanoseconds)
+	locked          bool               // Only players who were seated before may join
+	pin             string             // PIN required to join (empty if the room is open)
+	pinAdmitted     map[string]bool    // clientIDs that do not need the PIN (they entered it or were in the room when it was set)
+	waitlist        []*Client          // Clients waiting for a seat in a full room, first come first served
+	teams           []*team            // Teams in creation order (members are recorded on their Member)
+	teamSeq         int                // Number used in the next team ID
+	scoreLedger     []ScoreEntry       // Score changes, oldest first (capped at MaxScoreLedger)
+	scoreSeq        int                // Sequence number for the next score ledger entry
+	scoreVersion    uint64             // Incremented on every score change (sent with score_changed)
+	counters        []CounterDef       // Counters in definition order (values are recorded on each Member)
+	diceHistory     []DiceRoll         // Dice rolls, oldest first (capped at MaxDiceHistory)
+	diceSeq         int                // Sequence number for the next dice roll
 }
 
 // NewRoom creates a new room
 func NewRoom(id string) *Room {
-	return &Room{
-		ID:        id,
-		Clients:   make(map[string]*Client),
-		CreatedAt: time.Now(),
+	room := &Room{
+		ID:          id,
+		Clients:     make(map[string]*Client),
+		members:     make(map[string]*Member),
+		spectators:  make(map[string]*Client),
+		bans:        make(map[string]int64),
+		pinAdmitted: make(map[string]bool),
+		CreatedAt:   time.Now(),
+		round:       1,
 	}
+	room.settings.Normalize() // Start from the default settings
+	return room
 }
 
 // AddClient adds a client to the room (thread-safe)
@@ -38,14 +82,25 @@ func (r *Room) AddClient(client *Client) bool {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if r.Clients[client.ClientID] != nil {
+	if r.Clients[client.ClientID] != nil || r.spectators[client.ClientID] != nil {
 		return false // Already in room
 	}
 
-	r.Clients[client.ClientID] = client
+	r.seatClientLocked(client)
 	return true
 }
 
+// seatClientLocked adds a client to the room as a player
+// MUST be called with r.mu.Lock() held
+func (r *Room) seatClientLocked(client *Client) {
+	r.Clients[client.ClientID] = client
+	r.seats = append(r.seats, client.ClientID)
+	if r.host == "" {
+		r.host = client.ClientID
+	}
+	r.memberLocked(client.ClientID) // Reuses the existing record if the client is rejoining
+}
+
 // ListPeerIDs returns all client IDs in the room (kept for backward compatibility)
 func (r *Room) ListPeerIDs() []string {
 	r.mu.RLock()
@@ -64,7 +119,7 @@ func (r *Room) ListPeerIDs() []string {
 }
 
 // ListPeerInfo returns all peer information in the room
-// Creator is always returned last in the list for consistent ordering
+// Peers are returned in seating order
 func (r *Room) ListPeerInfo() []PeerInfo {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -73,28 +128,10 @@ func (r *Room) ListPeerInfo() []PeerInfo {
 		return nil
 	}
 
-	peers := make([]PeerInfo, 0, len(r.Clients))
-	var creatorPeer PeerInfo
-
-	for _, client := range r.Clients {
-		peerInfo := PeerInfo{
-			ClientID:      client.ClientID,
-			DisplayName:   client.DisplayName,
-			Color:         client.Color,
-			TotalTurnTime: client.TotalTurnTime,
-		}
-
-		// If this is the creator, save it for last
-		if client.ClientID == r.CreatedBy {
-			creatorPeer = peerInfo
-		} else {
-			peers = append(peers, peerInfo)
-		}
-	}
-
-	// Add creator last
-	if creatorPeer.ClientID != "" {
-		peers = append(peers, creatorPeer)
+	seats := r.seatOrderLocked()
+	peers := make([]PeerInfo, 0, len(seats))
+	for _, clientID := range seats {
+		peers = append(peers, r.peerInfoLocked(r.Clients[clientID]))
 	}
 
 	return peers
@@ -116,12 +153,26 @@ func (r *Room) GetCurrentTurnInfo() PeerInfo {
 		return PeerInfo{}
 	}
 
-	return PeerInfo{
+	return r.peerInfoLocked(client)
+}
+
+// peerInfoLocked returns the peer info for a client in the room
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) peerInfoLocked(client *Client) PeerInfo {
+	info := PeerInfo{
 		ClientID:      client.ClientID,
 		DisplayName:   client.DisplayName,
 		Color:         client.Color,
 		TotalTurnTime: client.TotalTurnTime,
 	}
+	if member := r.members[client.ClientID]; member != nil {
+		info.Passed = member.Passed
+		info.TeamID = member.TeamID
+		info.Presence = r.presenceLocked(client.ClientID)
+		info.Score = member.Score
+		info.Counters = r.countersLocked(member)
+	}
+	return info
 }
 
 // GetCurrentTurn returns the current turn client ID (thread-safe read)
@@ -153,55 +204,92 @@ func (r *Room) GetTurnSequence() uint64 {
 
 // SetCurrentTurn sets the current turn to the specified client ID atomically
 // Validates expectedCurrentTurn matches before setting (optimistic concurrency)
-// Returns true if turn was set, false if validation failed or client not found
-func (r *Room) SetCurrentTurn(expectedCurrentTurn, newClientID string) bool {
+// changedBy is the client that requested the change (recorded in the turn history)
+// Returns an error from checkSingleTurnChangeLocked if the turn may not change,
+// or ErrTurnStateMismatch if validation failed or the client is not in the room
+func (r *Room) SetCurrentTurn(expectedCurrentTurn, newClientID, changedBy string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if err := r.checkSingleTurnChangeLocked(changedBy); err != nil {
+		return err
+	}
+
 	// Check if client exists in room (O(1) lookup)
 	client := r.Clients[newClientID]
 	if client == nil {
-		return false // Client not in room
+		return ErrTurnStateMismatch // Client not in room
 	}
 
 	// Optimistic concurrency check: validate state matches
 	if r.CurrentTurn != expectedCurrentTurn {
-		return false // State mismatch
+		return ErrTurnStateMismatch
 	}
 
+	r.startTurnLocked(newClientID, turnEnd{by: changedBy, reason: TurnEndManual})
+	return nil
+}
+
+// startTurnLocked ends the active turn (if any) and starts a turn for clientID
+// end describes why the active turn ended
+// MUST be called with r.mu.Lock() held
+func (r *Room) startTurnLocked(clientID string, end turnEnd) {
+	r.saveUndoLocked(end)
+
 	// End current turn if one is active (calculate duration and add to client's total)
 	if r.CurrentTurn != "" && r.TurnStartTime != nil {
-		r.endCurrentTurnLocked()
+		r.endCurrentTurnLocked(end)
 	}
 
+	r.recordTurnStartLocked(clientID)
+	r.noteTeamTurnLocked(clientID)
+
 	// Set the new turn
-	r.CurrentTurn = newClientID
-	now := time.Now().UnixNano()
+	r.CurrentTurn = clientID
+	r.lastTurn = clientID
+	now := r.nowLocked()
 	r.TurnStartTime = &now
+	r.turnStartedAt = time.Now().UnixNano()
+	r.turnGen++
+}
 
-	return true
+// clearTurnLocked ends the active turn (if any) and leaves nobody with the turn
+// end describes why the active turn ended
+// MUST be called with r.mu.Lock() held
+func (r *Room) clearTurnLocked(end turnEnd) {
+	r.saveUndoLocked(end)
+	if r.CurrentTurn != "" && r.TurnStartTime != nil {
+		r.endCurrentTurnLocked(end)
+	}
+	r.CurrentTurn = ""
+	r.TurnStartTime = nil
+	r.turnGen++
 }
 
 // ClearCurrentTurn clears the current turn and adds duration to client's total
-func (r *Room) ClearCurrentTurn() {
+// changedBy is the client that ended the turn (recorded in the turn history)
+// Returns an error from checkSingleTurnChangeLocked if the turn may not change
+func (r *Room) ClearCurrentTurn(changedBy string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if r.CurrentTurn != "" && r.TurnStartTime != nil {
-		r.endCurrentTurnLocked()
+	if err := r.checkSingleTurnChangeLocked(changedBy); err != nil {
+		return err
 	}
-	r.CurrentTurn = ""
-	r.TurnStartTime = nil
+	r.clearTurnLocked(turnEnd{by: changedBy, reason: TurnEndManual})
+	return nil
 }
 
-// endCurrentTurnLocked calculates the duration of the current turn and adds it to the client's total
+// endCurrentTurnLocked calculates the duration of the current turn, adds it to the client's total
+// and records the turn in the history
 // MUST be called with r.mu.Lock() held
-func (r *Room) endCurrentTurnLocked() {
+func (r *Room) endCurrentTurnLocked(end turnEnd) {
 	if r.CurrentTurn == "" || r.TurnStartTime == nil {
 		return
 	}
 
-	now := time.Now().UnixNano()
+	// Game time stands still while paused, so paused time is never counted
+	now := r.nowLocked()
 	durationMs := (now - *r.TurnStartTime) / int64(time.Millisecond)
 
 	// Direct lookup - O(1)
@@ -209,13 +297,29 @@ func (r *Room) endCurrentTurnLocked() {
 	if client != nil {
 		client.TotalTurnTime += durationMs
 	}
+	r.chargeClockLocked(r.CurrentTurn, durationMs)
+	r.chargeTeamLocked(r.CurrentTurn, durationMs)
+	r.appendHistoryLocked(r.CurrentTurn, r.turnStartedAt, durationMs, end)
+	r.recordTurnEndLocked(r.CurrentTurn, durationMs)
 }
 
-// RemoveClient removes a client from the room (thread-safe)
-// Returns true if the removed client had the current turn
+// RemoveClient removes a client or spectator from the room (thread-safe)
+// Returns true if the removed client had the current turn (or a simultaneous phase was waiting on them),
+// and true if nobody (players or spectators) is left in the room
 func (r *Room) RemoveClient(clientID string) (bool, bool) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	return r.removeClientLocked(clientID)
+}
+
+// removeClientLocked removes a client or spectator from the room (see RemoveClient)
+// MUST be called with r.mu.Lock() held
+func (r *Room) removeClientLocked(clientID string) (bool, bool) {
+	// Spectators have no seat or turn to clean up
+	if r.spectators[clientID] != nil {
+		delete(r.spectators, clientID)
+		return false, len(r.Clients) == 0 && len(r.spectators) == 0
+	}
 
 	// Check if client exists in room (O(1) lookup)
 	client := r.Clients[clientID]
@@ -226,16 +330,30 @@ func (r *Room) RemoveClient(clientID string) (bool, bool) {
 	// Check if this client had the current turn
 	hadCurrentTurn := r.CurrentTurn == clientID
 
+	// Earlier turn changes may involve this client, so they can no longer be undone
+	r.undo = nil
+
 	if hadCurrentTurn && r.TurnStartTime != nil {
 		// End their turn (calculate duration)
-		r.endCurrentTurnLocked()
-		r.CurrentTurn = ""
-		r.TurnStartTime = nil
+		r.clearTurnLocked(turnEnd{by: clientID, reason: TurnEndDisconnect})
+	}
+
+	// A simultaneous phase waiting on this client stops waiting (counts as having the turn)
+	if r.removeFromPhaseLocked(clientID) {
+		hadCurrentTurn = true
 	}
 
 	// Direct delete - O(1)
 	delete(r.Clients, clientID)
+	r.removeSeatLocked(clientID)
+
+	// Everyone still seated may have passed already, which ends the round (announced like a turn end)
+	if r.ended == nil && r.allPassedLocked() {
+		r.clearTurnLocked(turnEnd{by: clientID, reason: TurnEndDisconnect})
+		r.completeRoundLocked()
+		hadCurrentTurn = true
+	}
 
-	isEmpty := len(r.Clients) == 0
+	isEmpty := len(r.Clients) == 0 && len(r.spectators) == 0
 	return hadCurrentTurn, isEmpty
 }
@@ -77,6 +77,7 @@ func (h *Hub) cleanupAbandonedRooms() {
 
 		if !isZero && age > RoomAbandonTimeout {
 			delete(h.rooms, roomID)
+			h.StopTurnTimers(roomID)
 			if clientCount > 0 {
 				log.Printf("Room cleanup: deleted room %s with %d active clients (age: %v)", roomID, clientCount, age.Round(time.Minute))
 			} else {
@@ -0,0 +1,118 @@
+package core
+
+import "time"
+
+// ClockState is a player's chess clock as sent to clients
+type ClockState struct {
+	ClientID    string `json:"client_id"`
+	RemainingMs int64  `json:"remaining_ms"` // Time bank at the start of the current turn (in milliseconds)
+	Flagged     bool   `json:"flagged"`      // Time bank ran out
+}
+
+// ListClocks returns every player's chess clock in seating order
+// Returns nil if the room has no chess clock
+func (r *Room) ListClocks() []ClockState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if !r.settings.ClockEnabled() {
+		return nil
+	}
+
+	seats := r.seatOrderLocked()
+	clocks := make([]ClockState, 0, len(seats))
+	for _, clientID := range seats {
+		clock := ClockState{ClientID: clientID, RemainingMs: r.settings.ClockBankMs}
+		if member := r.members[clientID]; member != nil {
+			clock.RemainingMs = member.TimeBankMs
+			clock.Flagged = member.Flagged
+		}
+		clocks = append(clocks, clock)
+	}
+	return clocks
+}
+
+// chargeClockLocked deducts a finished turn from the player's time bank
+// Applies the room's clock mode: Fischer adds the increment, Bronstein gives back
+// up to the increment, and simple delay only charges time beyond the delay
+// MUST be called with r.mu.Lock() held
+func (r *Room) chargeClockLocked(clientID string, durationMs int64) {
+	if !r.settings.ClockEnabled() {
+		return
+	}
+
+	member := r.memberLocked(clientID)
+	increment := r.settings.ClockIncrementMs
+
+	charge := durationMs
+	if r.settings.ClockMode == ClockDelay {
+		charge -= increment
+		if charge < 0 {
+			charge = 0
+		}
+	}
+
+	if member.Flagged || charge >= member.TimeBankMs {
+		member.TimeBankMs = 0
+		member.Flagged = true
+		return
+	}
+
+	member.TimeBankMs -= charge
+	switch r.settings.ClockMode {
+	case ClockFischer:
+		member.TimeBankMs += increment
+	case ClockBronstein:
+		if durationMs < increment {
+			member.TimeBankMs += durationMs
+		} else {
+			member.TimeBankMs += increment
+		}
+	}
+}
+
+// flagAtLocked returns when the active player's time bank runs out
+// Returns the zero time if there is no chess clock or the player is already flagged
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) flagAtLocked() time.Time {
+	if !r.settings.ClockEnabled() || r.CurrentTurn == "" || r.TurnStartTime == nil {
+		return time.Time{}
+	}
+
+	bank := r.settings.ClockBankMs
+	if member := r.members[r.CurrentTurn]; member != nil {
+		if member.Flagged {
+			return time.Time{}
+		}
+		bank = member.TimeBankMs
+	}
+	if r.settings.ClockMode == ClockDelay {
+		bank += r.settings.ClockIncrementMs
+	}
+	return time.Unix(0, *r.TurnStartTime).Add(time.Duration(bank) * time.Millisecond)
+}
+
+// flagClock marks the player of the turn identified by gen as out of time
+// Returns false if that turn is no longer active
+func (r *Room) flagClock(gen uint64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.turnGen != gen || r.CurrentTurn == "" {
+		return false
+	}
+
+	member := r.memberLocked(r.CurrentTurn)
+	member.TimeBankMs = 0
+	member.Flagged = true
+	return true
+}
+
+// resetClocksLocked gives every member a full time bank
+// MUST be called with r.mu.Lock() held
+func (r *Room) resetClocksLocked() {
+	for _, member := range r.members {
+		member.TimeBankMs = r.settings.ClockBankMs
+		member.Flagged = false
+	}
+}
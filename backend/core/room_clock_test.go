@@ -0,0 +1,188 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// setupClockRoom creates a two-player room with the given clock settings
+func setupClockRoom(t *testing.T, settings RoomSettings) *Room {
+	t.Helper()
+	room := NewRoom("TEST123")
+	if err := room.SetSettings(settings); err != nil {
+		t.Fatalf("Invalid settings: %v", err)
+	}
+	room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+	room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+	return room
+}
+
+// backdateTurn pretends the active turn started the given time ago
+func backdateTurn(room *Room, elapsed time.Duration) {
+	room.mu.Lock()
+	defer room.mu.Unlock()
+	start := time.Now().Add(-elapsed).UnixNano()
+	room.TurnStartTime = &start
+}
+
+// clockFor returns a player's clock from ListClocks
+func clockFor(t *testing.T, room *Room, clientID string) ClockState {
+	t.Helper()
+	for _, clock := range room.ListClocks() {
+		if clock.ClientID == clientID {
+			return clock
+		}
+	}
+	t.Fatalf("No clock for %s", clientID)
+	return ClockState{}
+}
+
+// withinMs reports whether got is within 50ms of want (turn timing is not exact)
+func withinMs(got, want int64) bool {
+	diff := got - want
+	return diff >= -50 && diff <= 50
+}
+
+func TestRoomClock(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		if clocks := room.ListClocks(); clocks != nil {
+			t.Errorf("Expected no clocks, got %v", clocks)
+		}
+	})
+
+	t.Run("StartsWithFullBank", func(t *testing.T) {
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 60000})
+		clocks := room.ListClocks()
+		if len(clocks) != 2 || clocks[0].ClientID != "client1" || clocks[1].ClientID != "client2" {
+			t.Fatalf("Expected clocks in seat order, got %v", clocks)
+		}
+		for _, clock := range clocks {
+			if clock.RemainingMs != 60000 || clock.Flagged {
+				t.Errorf("Expected full unflagged bank, got %+v", clock)
+			}
+		}
+		if room.GetSettings().ClockMode != ClockFischer {
+			t.Errorf("Expected default mode %s, got %s", ClockFischer, room.GetSettings().ClockMode)
+		}
+	})
+
+	t.Run("Fischer", func(t *testing.T) {
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 60000, ClockIncrementMs: 5000, ClockMode: ClockFischer})
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 10*time.Second)
+		room.SetCurrentTurn("client1", "client2", "")
+
+		// 60s - 10s + 5s
+		if got := clockFor(t, room, "client1").RemainingMs; !withinMs(got, 55000) {
+			t.Errorf("Expected ~55000ms, got %d", got)
+		}
+	})
+
+	t.Run("Bronstein", func(t *testing.T) {
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 60000, ClockIncrementMs: 5000, ClockMode: ClockBronstein})
+
+		// Short turn: the whole turn is given back
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 2*time.Second)
+		room.SetCurrentTurn("client1", "client2", "")
+		if got := clockFor(t, room, "client1").RemainingMs; !withinMs(got, 60000) {
+			t.Errorf("Expected ~60000ms after short turn, got %d", got)
+		}
+
+		// Long turn: only the increment is given back
+		backdateTurn(room, 10*time.Second)
+		room.SetCurrentTurn("client2", "client1", "")
+		if got := clockFor(t, room, "client2").RemainingMs; !withinMs(got, 55000) {
+			t.Errorf("Expected ~55000ms after long turn, got %d", got)
+		}
+	})
+
+	t.Run("SimpleDelay", func(t *testing.T) {
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 60000, ClockIncrementMs: 5000, ClockMode: ClockDelay})
+
+		// Turn within the delay costs nothing
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 3*time.Second)
+		room.SetCurrentTurn("client1", "client2", "")
+		if got := clockFor(t, room, "client1").RemainingMs; got != 60000 {
+			t.Errorf("Expected 60000ms after turn within delay, got %d", got)
+		}
+
+		// Only time beyond the delay is charged
+		backdateTurn(room, 8*time.Second)
+		room.SetCurrentTurn("client2", "client1", "")
+		if got := clockFor(t, room, "client2").RemainingMs; !withinMs(got, 57000) {
+			t.Errorf("Expected ~57000ms, got %d", got)
+		}
+	})
+
+	t.Run("BankRunsOut", func(t *testing.T) {
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 1000, ClockIncrementMs: 5000})
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 2*time.Second)
+		room.SetCurrentTurn("client1", "client2", "")
+
+		clock := clockFor(t, room, "client1")
+		if clock.RemainingMs != 0 || !clock.Flagged {
+			t.Errorf("Expected empty flagged bank without increment, got %+v", clock)
+		}
+	})
+
+	t.Run("SurvivesReconnect", func(t *testing.T) {
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 60000})
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 10*time.Second)
+		room.RemoveClient("client1")
+
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		if got := clockFor(t, room, "client1").RemainingMs; !withinMs(got, 50000) {
+			t.Errorf("Expected bank to survive rejoin (~50000ms), got %d", got)
+		}
+	})
+
+	t.Run("SettingsChangeResetsBanks", func(t *testing.T) {
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 60000})
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 10*time.Second)
+		room.ClearCurrentTurn("")
+
+		room.SetSettings(RoomSettings{ClockBankMs: 30000})
+		for _, clock := range room.ListClocks() {
+			if clock.RemainingMs != 30000 {
+				t.Errorf("Expected reset bank of 30000ms, got %+v", clock)
+			}
+		}
+	})
+
+	t.Run("FlagTimer", func(t *testing.T) {
+		hub := NewHub()
+		defer hub.Shutdown()
+		room := setupClockRoom(t, RoomSettings{ClockBankMs: 100})
+		hub.AddRoom(room.ID, room)
+
+		flagged := make(chan string, 1)
+		hub.OnClockFlagged = func(roomID, clientID string) { flagged <- clientID }
+
+		room.SetCurrentTurn("", "client1", "")
+		hub.ScheduleTurnTimers(room)
+
+		select {
+		case clientID := <-flagged:
+			if clientID != "client1" {
+				t.Errorf("Expected client1 to flag, got %s", clientID)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for clock_flagged")
+		}
+
+		clock := clockFor(t, room, "client1")
+		if !clock.Flagged || clock.RemainingMs != 0 {
+			t.Errorf("Expected client1 flagged with empty bank, got %+v", clock)
+		}
+		if room.GetCurrentTurn() != "client1" {
+			t.Error("Expected turn to keep running after flag")
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package core
+
+import "errors"
+
+const (
+	// MaxCounters caps the number of counters a room can define
+	MaxCounters = 16
+	// MaxCounterValue caps the absolute value of a counter (and of its bounds and default)
+	MaxCounterValue = 1_000_000_000
+)
+
+// CounterDef is a named per-player counter, such as life total or a resource
+type CounterDef struct {
+	Name    string `json:"name"`
+	Min     *int64 `json:"min,omitempty"` // Lowest allowed value (nil if unbounded)
+	Max     *int64 `json:"max,omitempty"` // Highest allowed value (nil if unbounded)
+	Default int64  `json:"default"`       // Value every player starts with
+}
+
+// Validate checks that the bounds and default are consistent and in range
+func (d CounterDef) Validate() error {
+	if outOfCounterRange(d.Default) || (d.Min != nil && outOfCounterRange(*d.Min)) || (d.Max != nil && outOfCounterRange(*d.Max)) {
+		return errors.New("Counter out of range")
+	}
+	if d.Min != nil && d.Max != nil && *d.Min > *d.Max {
+		return errors.New("Counter min is above max")
+	}
+	if !d.allows(d.Default) {
+		return errors.New("Counter default is outside its bounds")
+	}
+	return nil
+}
+
+// allows reports whether a value is within the counter's bounds
+func (d CounterDef) allows(value int64) bool {
+	return (d.Min == nil || value >= *d.Min) && (d.Max == nil || value <= *d.Max)
+}
+
+// clamp moves a value into the counter's bounds
+func (d CounterDef) clamp(value int64) int64 {
+	if d.Min != nil && value < *d.Min {
+		return *d.Min
+	}
+	if d.Max != nil && value > *d.Max {
+		return *d.Max
+	}
+	return value
+}
+
+func outOfCounterRange(value int64) bool {
+	return value > MaxCounterValue || value < -MaxCounterValue
+}
+
+// DefineCounter adds a counter to the room, or replaces the definition of an existing one (thread-safe)
+// Every player starts a new counter at its default; redefining a counter keeps each player's value,
+// moved into the new bounds
+func (r *Room) DefineCounter(def CounterDef) error {
+	if err := def.Validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return err
+	}
+
+	if i := r.counterIndexLocked(def.Name); i >= 0 {
+		r.counters[i] = def
+		for _, member := range r.members {
+			if value, ok := member.Counters[def.Name]; ok {
+				member.Counters[def.Name] = def.clamp(value)
+			}
+		}
+		return nil
+	}
+
+	if len(r.counters) >= MaxCounters {
+		return errors.New("Too many counters")
+	}
+	r.counters = append(r.counters, def)
+	return nil
+}
+
+// RemoveCounter deletes a counter and every player's value for it (thread-safe)
+func (r *Room) RemoveCounter(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return err
+	}
+
+	i := r.counterIndexLocked(name)
+	if i < 0 {
+		return errors.New("Counter not found")
+	}
+	r.counters = append(r.counters[:i], r.counters[i+1:]...)
+	for _, member := range r.members {
+		delete(member.Counters, name)
+	}
+	return nil
+}
+
+// ListCounters returns the room's counters in definition order (thread-safe read)
+// Returns nil if the room has no counters
+func (r *Room) ListCounters() []CounterDef {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.counters) == 0 {
+		return nil
+	}
+	counters := make([]CounterDef, len(r.counters))
+	copy(counters, r.counters)
+	return counters
+}
+
+// ChangeCounter sets a seated player's counter, or adjusts it if delta is true (thread-safe)
+// Values outside the counter's bounds are rejected rather than clamped
+// Returns the counter's new value
+func (r *Room) ChangeCounter(clientID, name string, value int64, delta bool) (int64, error) {
+	// No valid change is larger than this, and bounding it first keeps the arithmetic from overflowing
+	if value > 2*MaxCounterValue || value < -2*MaxCounterValue {
+		return 0, errors.New("Counter out of range")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return 0, err
+	}
+
+	if r.Clients[clientID] == nil {
+		return 0, errors.New("Player not found")
+	}
+	i := r.counterIndexLocked(name)
+	if i < 0 {
+		return 0, errors.New("Counter not found")
+	}
+	def := r.counters[i]
+
+	member := r.memberLocked(clientID)
+	if delta {
+		value += r.counterValueLocked(member, def)
+	}
+	if outOfCounterRange(value) || !def.allows(value) {
+		return 0, errors.New("Counter out of range")
+	}
+
+	if member.Counters == nil {
+		member.Counters = make(map[string]int64)
+	}
+	member.Counters[name] = value
+	return value, nil
+}
+
+// counterIndexLocked returns the index of the named counter, or -1
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) counterIndexLocked(name string) int {
+	for i, def := range r.counters {
+		if def.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// counterValueLocked returns a member's value for a counter (the default until it is first changed)
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) counterValueLocked(member *Member, def CounterDef) int64 {
+	if value, ok := member.Counters[def.Name]; ok {
+		return value
+	}
+	return def.Default
+}
+
+// countersLocked returns every counter value for a member, keyed by counter name
+// Returns nil if the room has no counters
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) countersLocked(member *Member) map[string]int64 {
+	if len(r.counters) == 0 {
+		return nil
+	}
+	counters := make(map[string]int64, len(r.counters))
+	for _, def := range r.counters {
+		counters[def.Name] = r.counterValueLocked(member, def)
+	}
+	return counters
+}
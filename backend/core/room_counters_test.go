@@ -0,0 +1,131 @@
+package core
+
+import "testing"
+
+func int64Ptr(v int64) *int64 { return &v }
+
+// setupCounterRoom creates a two-player room with a life counter from 0 to 20
+func setupCounterRoom(t *testing.T) *Room {
+	t.Helper()
+	room := setupScoreRoom()
+	if err := room.DefineCounter(CounterDef{Name: "Life", Min: int64Ptr(0), Max: int64Ptr(20), Default: 20}); err != nil {
+		t.Fatalf("DefineCounter failed: %v", err)
+	}
+	return room
+}
+
+func TestRoomCounters(t *testing.T) {
+	t.Run("DefaultsInPeerInfo", func(t *testing.T) {
+		room := setupCounterRoom(t)
+		for _, peer := range room.ListPeerInfo() {
+			if peer.Counters["Life"] != 20 {
+				t.Errorf("Expected %s to start at the default 20, got %v", peer.ClientID, peer.Counters)
+			}
+		}
+		if snapshot := room.Snapshot(); len(snapshot.Counters) != 1 || snapshot.Counters[0].Name != "Life" {
+			t.Errorf("Expected the counter in the snapshot, got %+v", snapshot.Counters)
+		}
+	})
+
+	t.Run("AdjustAndSet", func(t *testing.T) {
+		room := setupCounterRoom(t)
+		value, err := room.ChangeCounter("client1", "Life", -3, true)
+		if err != nil || value != 17 {
+			t.Fatalf("Expected 17, got %d (%v)", value, err)
+		}
+		if value, _ := room.ChangeCounter("client1", "Life", 5, false); value != 5 {
+			t.Errorf("Expected 5, got %d", value)
+		}
+		if peers := room.ListPeerInfo(); peers[0].Counters["Life"] != 5 || peers[1].Counters["Life"] != 20 {
+			t.Errorf("Expected only client1 to change, got %v and %v", peers[0].Counters, peers[1].Counters)
+		}
+	})
+
+	t.Run("BoundsRejected", func(t *testing.T) {
+		room := setupCounterRoom(t)
+		if _, err := room.ChangeCounter("client1", "Life", 1, true); err == nil {
+			t.Error("Expected error above max")
+		}
+		if _, err := room.ChangeCounter("client1", "Life", -1, false); err == nil {
+			t.Error("Expected error below min")
+		}
+		if _, err := room.ChangeCounter("client1", "Life", 1<<62, true); err == nil {
+			t.Error("Expected error for a huge delta")
+		}
+		if _, err := room.ChangeCounter("client1", "Mana", 1, true); err == nil {
+			t.Error("Expected error for an unknown counter")
+		}
+		if _, err := room.ChangeCounter("ghost", "Life", 1, true); err == nil {
+			t.Error("Expected error for a player not in the room")
+		}
+		if peers := room.ListPeerInfo(); peers[0].Counters["Life"] != 20 {
+			t.Errorf("Expected rejected changes to leave the value alone, got %v", peers[0].Counters)
+		}
+	})
+
+	t.Run("DefinitionValidation", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		bad := []CounterDef{
+			{Name: "A", Min: int64Ptr(5), Max: int64Ptr(1)},
+			{Name: "B", Min: int64Ptr(1), Default: 0},
+			{Name: "C", Default: MaxCounterValue + 1},
+		}
+		for _, def := range bad {
+			if err := room.DefineCounter(def); err == nil {
+				t.Errorf("Expected error for %+v", def)
+			}
+		}
+		for i := 0; i < MaxCounters; i++ {
+			room.DefineCounter(CounterDef{Name: string(rune('a' + i))})
+		}
+		if err := room.DefineCounter(CounterDef{Name: "Extra"}); err == nil {
+			t.Error("Expected error past MaxCounters")
+		}
+	})
+
+	t.Run("RedefineClampsValues", func(t *testing.T) {
+		room := setupCounterRoom(t)
+		room.ChangeCounter("client1", "Life", 15, false)
+		if err := room.DefineCounter(CounterDef{Name: "Life", Min: int64Ptr(0), Max: int64Ptr(10), Default: 10}); err != nil {
+			t.Fatalf("DefineCounter failed: %v", err)
+		}
+		if peers := room.ListPeerInfo(); peers[0].Counters["Life"] != 10 || peers[1].Counters["Life"] != 10 {
+			t.Errorf("Expected both values at 10, got %v and %v", peers[0].Counters, peers[1].Counters)
+		}
+		if len(room.ListCounters()) != 1 {
+			t.Errorf("Expected redefining to keep one counter, got %d", len(room.ListCounters()))
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		room := setupCounterRoom(t)
+		room.ChangeCounter("client1", "Life", 3, false)
+		if err := room.RemoveCounter("Life"); err != nil {
+			t.Fatalf("RemoveCounter failed: %v", err)
+		}
+		if err := room.RemoveCounter("Life"); err == nil {
+			t.Error("Expected removing twice to fail")
+		}
+		// A new counter with the same name starts over from its default
+		room.DefineCounter(CounterDef{Name: "Life", Default: 40})
+		if peers := room.ListPeerInfo(); peers[0].Counters["Life"] != 40 {
+			t.Errorf("Expected the old value gone, got %v", peers[0].Counters)
+		}
+	})
+
+	t.Run("SurvivesReconnectAndUndo", func(t *testing.T) {
+		room := setupCounterRoom(t)
+		room.SetSettings(RoomSettings{UndoDepth: 3})
+		room.SetCurrentTurn("", "client1", "")
+		room.ChangeCounter("client1", "Life", 7, false)
+		if err := room.UndoTurn("client1", "client1"); err != nil {
+			t.Fatalf("UndoTurn failed: %v", err)
+		}
+
+		room.RemoveClient("client1")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		if peers := room.ListPeerInfo(); peers[1].Counters["Life"] != 7 {
+			t.Errorf("Expected the counter to survive undo and rejoin, got %v", peers[1].Counters)
+		}
+	})
+}
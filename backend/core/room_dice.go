@@ -0,0 +1,89 @@
+package core
+
+import "time"
+
+// MaxDiceHistory caps the dice rolls kept per room (the oldest are dropped first)
+const MaxDiceHistory = 1000
+
+// DiceRoll is a roll in the room's dice history
+type DiceRoll struct {
+	Seq      int    `json:"seq"` // Position in the room's dice history, starting at 0 (never reused)
+	ClientID string `json:"client_id"`
+	Notation string `json:"notation"`          // Dice notation as requested (e.g. 4d6kh3)
+	Dice     []int  `json:"dice"`              // Every die in the order rolled
+	Dropped  []int  `json:"dropped,omitempty"` // Indexes into Dice of the dice left out of the total (kh/kl only)
+	Modifier int    `json:"modifier"`          // Added to the kept dice
+	Total    int    `json:"total"`
+	At       int64  `json:"at"` // Unix timestamp in milliseconds of the roll
+}
+
+// DiceHistoryPage is a page of the room's dice history
+type DiceHistoryPage struct {
+	Rolls  []DiceRoll `json:"rolls"`  // Oldest first
+	Offset int        `json:"offset"` // Index of the first returned roll among the kept rolls
+	Total  int        `json:"total"`  // Number of rolls kept
+}
+
+// RollDice rolls dice for a player and records the roll in the dice history (thread-safe)
+// The dice are rolled on the server, so the result is the same for everyone in the room
+func (r *Room) RollDice(clientID, notation string) (DiceRoll, error) {
+	spec, err := ParseDice(notation)
+	if err != nil {
+		return DiceRoll{}, err
+	}
+	dice, dropped, total, err := spec.Roll()
+	if err != nil {
+		return DiceRoll{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return DiceRoll{}, err
+	}
+
+	roll := DiceRoll{
+		Seq:      r.diceSeq,
+		ClientID: clientID,
+		Notation: notation,
+		Dice:     dice,
+		Dropped:  dropped,
+		Modifier: spec.Modifier,
+		Total:    total,
+		At:       time.Now().UnixMilli(),
+	}
+	r.diceSeq++
+
+	if len(r.diceHistory) >= MaxDiceHistory {
+		// Drop the oldest roll, copying so the backing array does not grow forever
+		r.diceHistory = append(r.diceHistory[:0:0], r.diceHistory[1:]...)
+	}
+	r.diceHistory = append(r.diceHistory, roll)
+	return roll, nil
+}
+
+// GetDiceHistory returns a page of the dice history, oldest first (thread-safe read)
+// Page sizes follow the turn history (see GetHistory)
+func (r *Room) GetDiceHistory(offset, limit int) DiceHistoryPage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if limit <= 0 {
+		limit = DefaultHistoryPageSize
+	}
+	if limit > MaxHistoryPageSize {
+		limit = MaxHistoryPageSize
+	}
+
+	page := DiceHistoryPage{Rolls: []DiceRoll{}, Offset: offset, Total: len(r.diceHistory)}
+	if offset < 0 || offset >= len(r.diceHistory) {
+		return page
+	}
+	end := offset + limit
+	if end > len(r.diceHistory) {
+		end = len(r.diceHistory)
+	}
+	page.Rolls = append(page.Rolls, r.diceHistory[offset:end]...)
+	return page
+}
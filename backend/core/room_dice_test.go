@@ -0,0 +1,43 @@
+package core
+
+import "testing"
+
+func TestRoomDice(t *testing.T) {
+	t.Run("RollRecordedInHistory", func(t *testing.T) {
+		room := setupScoreRoom()
+		roll, err := room.RollDice("client1", "2d6+1")
+		if err != nil {
+			t.Fatalf("RollDice failed: %v", err)
+		}
+		if roll.ClientID != "client1" || roll.Notation != "2d6+1" || len(roll.Dice) != 2 || roll.Total != roll.Dice[0]+roll.Dice[1]+1 {
+			t.Errorf("Unexpected roll %+v", roll)
+		}
+
+		room.RollDice("client2", "1d20")
+		page := room.GetDiceHistory(0, 0)
+		if page.Total != 2 || page.Rolls[0].Seq != 0 || page.Rolls[1].ClientID != "client2" {
+			t.Errorf("Expected both rolls in order, got %+v", page)
+		}
+	})
+
+	t.Run("InvalidNotationNotRecorded", func(t *testing.T) {
+		room := setupScoreRoom()
+		if _, err := room.RollDice("client1", "lots"); err == nil {
+			t.Error("Expected error for invalid notation")
+		}
+		if page := room.GetDiceHistory(0, 0); page.Total != 0 {
+			t.Errorf("Expected no rolls recorded, got %d", page.Total)
+		}
+	})
+
+	t.Run("HistoryCapped", func(t *testing.T) {
+		room := setupScoreRoom()
+		for i := 0; i < MaxDiceHistory+5; i++ {
+			room.RollDice("client1", "1d6")
+		}
+		page := room.GetDiceHistory(0, 1)
+		if page.Total != MaxDiceHistory || page.Rolls[0].Seq != 5 {
+			t.Errorf("Expected %d rolls starting at seq 5, got %d starting at %d", MaxDiceHistory, page.Total, page.Rolls[0].Seq)
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// PlayerSummary is one player's statistics for the whole game
+type PlayerSummary struct {
+	ClientID      string `json:"client_id"`
+	DisplayName   string `json:"display_name,omitempty"` // Empty if the player left before the game ended
+	Color         string `json:"color,omitempty"`        // Empty if the player left before the game ended
+	Turns         int    `json:"turns"`                  // Finished turns (including simultaneous phases)
+	TotalTimeMs   int64  `json:"total_time_ms"`          // Time spent in turns (in milliseconds)
+	AverageTurnMs int64  `json:"average_turn_ms"`
+	MedianTurnMs  int64  `json:"median_turn_ms"`
+	LongestTurnMs int64  `json:"longest_turn_ms"`
+}
+
+// GameSummary is the end-of-game summary of a room
+type GameSummary struct {
+	EndedBy   string          `json:"ended_by"`   // Client ID that ended the game
+	StartedAt int64           `json:"started_at"` // Unix timestamp in milliseconds when the room was created
+	EndedAt   int64           `json:"ended_at"`   // Unix timestamp in milliseconds when the game ended
+	SessionMs int64           `json:"session_ms"` // Wall-clock length of the session (in milliseconds, pauses included)
+	Rounds    int             `json:"rounds"`     // Rounds in which at least one turn was played
+	Players   []PlayerSummary `json:"players"`    // Seated players in seating order, then players who left (by client ID)
+}
+
+// EndGame ends the game and freezes the room (thread-safe)
+// The active turn or simultaneous phase is ended and counted; afterwards the room stays readable but turns cannot change
+// Returns the summary and true if a turn or phase was cut short, or an error if the game already ended
+func (r *Room) EndGame(clientID string) (GameSummary, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ended != nil {
+		return GameSummary{}, false, errors.New("Game has already ended")
+	}
+
+	end := turnEnd{by: clientID, reason: TurnEndManual}
+	cutShort := r.CurrentTurn != "" || r.phase != nil
+	if r.phase != nil {
+		for _, player := range r.phase.players {
+			if r.isWaitingLocked(player) {
+				r.chargePhaseTimeLocked(player, end)
+			}
+		}
+		r.phase = nil
+	}
+	r.clearTurnLocked(end)
+	r.undo = nil
+	r.pausedAt = nil
+	r.pausedBy = ""
+
+	summary := r.gameSummaryLocked(clientID, time.Now())
+	r.ended = &summary
+	return summary, cutShort, nil
+}
+
+// IsEnded reports whether the game has ended (thread-safe read)
+func (r *Room) IsEnded() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.ended != nil
+}
+
+// GetSummary returns the end-of-game summary, or nil if the game has not ended (thread-safe read)
+func (r *Room) GetSummary() *GameSummary {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.ended == nil {
+		return nil
+	}
+	summary := *r.ended
+	summary.Players = append([]PlayerSummary(nil), r.ended.Players...)
+	return &summary
+}
+
+// gameSummaryLocked builds the end-of-game summary from every member's finished turns
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) gameSummaryLocked(endedBy string, endedAt time.Time) GameSummary {
+	summary := GameSummary{
+		EndedBy:   endedBy,
+		StartedAt: r.CreatedAt.UnixMilli(),
+		EndedAt:   endedAt.UnixMilli(),
+		SessionMs: endedAt.Sub(r.CreatedAt).Milliseconds(),
+		Rounds:    r.round,
+		Players:   []PlayerSummary{},
+	}
+	if len(r.roundPlayers) == 0 {
+		summary.Rounds-- // The current round has not started
+	}
+
+	seen := make(map[string]bool, len(r.seats))
+	for _, clientID := range r.seatOrderLocked() {
+		seen[clientID] = true
+		summary.Players = append(summary.Players, r.playerSummaryLocked(clientID))
+	}
+
+	var departed []string
+	for clientID, member := range r.members {
+		if !seen[clientID] && len(member.TurnTimesMs) > 0 {
+			departed = append(departed, clientID)
+		}
+	}
+	sort.Strings(departed)
+	for _, clientID := range departed {
+		summary.Players = append(summary.Players, r.playerSummaryLocked(clientID))
+	}
+	return summary
+}
+
+// playerSummaryLocked computes a player's statistics from their finished turns
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) playerSummaryLocked(clientID string) PlayerSummary {
+	player := PlayerSummary{ClientID: clientID}
+	if client := r.Clients[clientID]; client != nil {
+		player.DisplayName = client.DisplayName
+		player.Color = client.Color
+	}
+
+	member := r.members[clientID]
+	if member == nil || len(member.TurnTimesMs) == 0 {
+		return player
+	}
+
+	durations := append([]int64(nil), member.TurnTimesMs...)
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	for _, duration := range durations {
+		player.TotalTimeMs += duration
+	}
+
+	n := len(durations)
+	player.Turns = n
+	player.AverageTurnMs = player.TotalTimeMs / int64(n)
+	player.LongestTurnMs = durations[n-1]
+	if n%2 == 1 {
+		player.MedianTurnMs = durations[n/2]
+	} else {
+		player.MedianTurnMs = (durations[n/2-1] + durations[n/2]) / 2
+	}
+	return player
+}
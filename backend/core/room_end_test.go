@@ -0,0 +1,123 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// playTurn gives clientID the turn and pretends it has been running for elapsed
+func playTurn(room *Room, clientID string, elapsed time.Duration) {
+	room.SetCurrentTurn(room.GetCurrentTurn(), clientID, clientID)
+	backdateTurn(room, elapsed)
+}
+
+func TestRoomEndGame(t *testing.T) {
+	t.Run("Summary", func(t *testing.T) {
+		room := setupRoundRoom()
+		playTurn(room, "client1", time.Second)
+		playTurn(room, "client2", time.Second)
+		playTurn(room, "client3", 2*time.Second)
+		playTurn(room, "client1", 3*time.Second)
+		playTurn(room, "client2", 4*time.Second)
+		room.RemoveClient("client3")
+
+		summary, cutShort, err := room.EndGame("client1")
+		if err != nil {
+			t.Fatalf("Expected game to end, got %v", err)
+		}
+		if !cutShort {
+			t.Error("Expected the active turn to be cut short")
+		}
+		if summary.EndedBy != "client1" || summary.Rounds != 2 || summary.SessionMs < 0 || summary.EndedAt < summary.StartedAt {
+			t.Errorf("Unexpected summary: %+v", summary)
+		}
+		if len(summary.Players) != 3 {
+			t.Fatalf("Expected 3 players, got %+v", summary.Players)
+		}
+
+		alice := summary.Players[0]
+		if alice.ClientID != "client1" || alice.DisplayName != "Alice" || alice.Turns != 2 {
+			t.Errorf("Unexpected first player: %+v", alice)
+		}
+		if !withinMs(alice.TotalTimeMs, 4000) || !withinMs(alice.AverageTurnMs, 2000) ||
+			!withinMs(alice.MedianTurnMs, 2000) || !withinMs(alice.LongestTurnMs, 3000) {
+			t.Errorf("Unexpected statistics for client1: %+v", alice)
+		}
+		if bob := summary.Players[1]; bob.Turns != 2 || !withinMs(bob.MedianTurnMs, 2500) || !withinMs(bob.LongestTurnMs, 4000) {
+			t.Errorf("Unexpected statistics for client2: %+v", bob)
+		}
+		if carol := summary.Players[2]; carol.ClientID != "client3" || carol.DisplayName != "" || carol.Turns != 1 || !withinMs(carol.TotalTimeMs, 2000) {
+			t.Errorf("Expected departed client3 last, got %+v", carol)
+		}
+	})
+
+	t.Run("FreezesRoom", func(t *testing.T) {
+		room := setupRoundRoom()
+		playTurn(room, "client1", time.Second)
+		room.Pause("client1")
+
+		if _, _, err := room.EndGame("client2"); err != nil {
+			t.Fatalf("Expected game to end, got %v", err)
+		}
+		if !room.IsEnded() || room.GetCurrentTurn() != "" || room.IsPaused() {
+			t.Error("Expected an ended room with no active turn and a running clock")
+		}
+		if _, _, err := room.EndGame("client2"); err == nil || err.Error() != "Game has already ended" {
+			t.Errorf("Expected already ended error, got %v", err)
+		}
+		if err := room.SetCurrentTurn("", "client1", "client1"); err != ErrGameEnded {
+			t.Errorf("Expected turn changes to be refused, got %v", err)
+		}
+		if err := room.UndoTurn("", "client1"); err != ErrGameEnded {
+			t.Errorf("Expected undo to be refused, got %v", err)
+		}
+		if _, _, err := room.PickRandomPlayer(); err != ErrGameEnded {
+			t.Errorf("Expected picking a player to be refused, got %v", err)
+		}
+		if err := room.SetSeatOrder(room.GetSeatOrder(), "client1"); err != ErrGameEnded {
+			t.Errorf("Expected reordering seats to be refused, got %v", err)
+		}
+		if _, _, err := room.ChangeScore("client1", 5, true, "", "client1"); err != ErrGameEnded {
+			t.Errorf("Expected score changes to be refused, got %v", err)
+		}
+		if _, err := room.CreateTeam("Red", "#FF0000"); err != ErrGameEnded {
+			t.Errorf("Expected team changes to be refused, got %v", err)
+		}
+		if _, err := room.Pause("client1"); err != ErrGameEnded {
+			t.Errorf("Expected pausing to be refused, got %v", err)
+		}
+
+		snapshot := room.Snapshot()
+		if snapshot.Summary == nil || snapshot.Summary.EndedBy != "client2" || snapshot.Summary.Players[0].Turns != 1 {
+			t.Errorf("Expected summary in snapshot, got %+v", snapshot.Summary)
+		}
+	})
+
+	t.Run("EndsPhase", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		backdatePhase(room, 2*time.Second)
+		room.MarkReady("client1")
+
+		summary, cutShort, err := room.EndGame("client1")
+		if err != nil || !cutShort {
+			t.Fatalf("Expected the phase to be cut short, got %v", err)
+		}
+		if room.GetPhase() != nil {
+			t.Error("Expected no phase after the game ended")
+		}
+		for _, player := range summary.Players {
+			if player.Turns != 1 || !withinMs(player.TotalTimeMs, 2000) {
+				t.Errorf("Expected one 2s turn for %s, got %+v", player.ClientID, player)
+			}
+		}
+	})
+
+	t.Run("NothingPlayed", func(t *testing.T) {
+		room := setupRoundRoom()
+		summary, cutShort, _ := room.EndGame("client1")
+		if cutShort || summary.Rounds != 0 || len(summary.Players) != 3 || summary.Players[0].Turns != 0 {
+			t.Errorf("Unexpected summary for an unplayed game: %+v", summary)
+		}
+	})
+}
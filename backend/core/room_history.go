@@ -0,0 +1,124 @@
+package core
+
+import "time"
+
+const (
+	// MaxTurnHistory caps the turns kept per room (the oldest are dropped first)
+	MaxTurnHistory = 1000
+	// DefaultHistoryPageSize is the number of turns returned when no limit is given
+	DefaultHistoryPageSize = 50
+	// MaxHistoryPageSize caps the number of turns returned per page
+	MaxHistoryPageSize = 200
+)
+
+// Turn end reasons - why a turn in the history ended
+const (
+	TurnEndManual     = "manual"     // A player ended, changed or passed the turn
+	TurnEndDisconnect = "disconnect" // The player left or disconnected
+	TurnEndTimeout    = "timeout"    // The turn reached its time limit
+	TurnEndUndo       = "undo"       // Not a turn - records that the turns listed in Undoes were undone
+)
+
+// TurnRecord is a finished turn in the room's history
+type TurnRecord struct {
+	Seq        int    `json:"seq"` // Position in the room's history, starting at 0 (never reused)
+	ClientID   string `json:"client_id"`
+	Round      int    `json:"round"`              // Round the turn was played in
+	StartTime  int64  `json:"start_time"`         // Unix timestamp in milliseconds when the turn started
+	EndTime    int64  `json:"end_time"`           // Unix timestamp in milliseconds when the turn ended
+	DurationMs int64  `json:"duration_ms"`        // Time counted for the turn (paused time excluded)
+	EndedBy    string `json:"ended_by,omitempty"` // clientID that triggered the end (empty if the server did)
+	EndReason  string `json:"end_reason"`         // manual, disconnect, timeout or undo
+	Undoes     []int  `json:"undoes,omitempty"`   // Seqs of the turns reverted by an undo record (undo only)
+}
+
+// HistoryPage is a page of the room's turn history
+type HistoryPage struct {
+	Turns  []TurnRecord `json:"turns"`  // Oldest first
+	Offset int          `json:"offset"` // Index of the first returned turn among the kept turns
+	Total  int          `json:"total"`  // Number of turns kept
+}
+
+// turnEnd describes who ended a turn and why
+type turnEnd struct {
+	by     string // clientID that triggered the end (empty for the server)
+	reason string // One of the TurnEnd* reasons
+}
+
+// GetHistory returns a page of the turn history, oldest first (thread-safe read)
+// A limit of 0 uses DefaultHistoryPageSize; larger limits are capped at MaxHistoryPageSize
+func (r *Room) GetHistory(offset, limit int) HistoryPage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if limit <= 0 {
+		limit = DefaultHistoryPageSize
+	}
+	if limit > MaxHistoryPageSize {
+		limit = MaxHistoryPageSize
+	}
+
+	page := HistoryPage{Turns: []TurnRecord{}, Offset: offset, Total: len(r.history)}
+	if offset < 0 || offset >= len(r.history) {
+		return page
+	}
+	end := offset + limit
+	if end > len(r.history) {
+		end = len(r.history)
+	}
+	page.Turns = append(page.Turns, r.history[offset:end]...)
+	return page
+}
+
+// appendHistoryLocked records a finished turn
+// startedAt is the wall-clock start of the turn in nanoseconds
+// MUST be called with r.mu.Lock() held
+func (r *Room) appendHistoryLocked(clientID string, startedAt, durationMs int64, end turnEnd) {
+	record := TurnRecord{
+		Seq:        r.historySeq,
+		ClientID:   clientID,
+		Round:      r.round,
+		StartTime:  startedAt / int64(time.Millisecond),
+		EndTime:    time.Now().UnixMilli(),
+		DurationMs: durationMs,
+		EndedBy:    end.by,
+		EndReason:  end.reason,
+	}
+
+	// Every finished turn counts towards the end-of-game summary, even once it drops out of the history
+	member := r.memberLocked(clientID)
+	member.TurnTimesMs = append(member.TurnTimesMs, durationMs)
+
+	r.recordHistoryLocked(record)
+}
+
+// appendUndoHistoryLocked records that every turn from fromSeq on was undone
+// The undone turns are kept, so the history stays append-only for clients paging through it
+// MUST be called with r.mu.Lock() held
+func (r *Room) appendUndoHistoryLocked(undoneBy string, fromSeq int) {
+	now := time.Now().UnixMilli()
+	record := TurnRecord{
+		Seq:       r.historySeq,
+		ClientID:  undoneBy,
+		Round:     r.round,
+		StartTime: now,
+		EndTime:   now,
+		EndedBy:   undoneBy,
+		EndReason: TurnEndUndo,
+	}
+	for seq := fromSeq; seq < r.historySeq; seq++ {
+		record.Undoes = append(record.Undoes, seq)
+	}
+	r.recordHistoryLocked(record)
+}
+
+// recordHistoryLocked appends a record to the history, dropping the oldest once it is full
+// MUST be called with r.mu.Lock() held
+func (r *Room) recordHistoryLocked(record TurnRecord) {
+	r.historySeq++
+	if len(r.history) >= MaxTurnHistory {
+		// Drop the oldest record, copying so the backing array does not grow forever
+		r.history = append(r.history[:0:0], r.history[1:]...)
+	}
+	r.history = append(r.history, record)
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoomHistory(t *testing.T) {
+	t.Run("EmptyByDefault", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		page := room.GetHistory(0, 0)
+		if page.Total != 0 || len(page.Turns) != 0 {
+			t.Errorf("Expected empty history, got %+v", page)
+		}
+	})
+
+	t.Run("RecordsFinishedTurns", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+		room.SetCurrentTurn("", "client1", "client2")
+		backdateTurn(room, 3*time.Second)
+		room.SetCurrentTurn("client1", "client2", "client1")
+		room.ClearCurrentTurn("client2")
+
+		page := room.GetHistory(0, 0)
+		if page.Total != 2 || len(page.Turns) != 2 {
+			t.Fatalf("Expected 2 turns, got %+v", page)
+		}
+		first := page.Turns[0]
+		if first.Seq != 0 || first.ClientID != "client1" || first.EndedBy != "client1" || first.EndReason != TurnEndManual {
+			t.Errorf("Unexpected first turn: %+v", first)
+		}
+		if !withinMs(first.DurationMs, 3000) || first.EndTime < first.StartTime || first.Round != 1 {
+			t.Errorf("Unexpected first turn timing: %+v", first)
+		}
+		if second := page.Turns[1]; second.ClientID != "client2" || second.EndedBy != "client2" {
+			t.Errorf("Unexpected second turn: %+v", second)
+		}
+	})
+
+	t.Run("RecordsDisconnect", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		room.SetCurrentTurn("", "client1", "client1")
+		room.RemoveClient("client1")
+
+		turns := room.GetHistory(0, 0).Turns
+		if len(turns) != 1 || turns[0].EndReason != TurnEndDisconnect || turns[0].EndedBy != "client1" {
+			t.Errorf("Expected disconnect record for client1, got %+v", turns)
+		}
+	})
+
+	t.Run("RecordsTimeout", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.SetSettings(RoomSettings{TurnTimeLimitMs: 1000, TurnExpiryPolicy: TurnExpiryEnd})
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.SetCurrentTurn("", "client1", "client1")
+		room.expireTurn(room.turnGen)
+
+		turns := room.GetHistory(0, 0).Turns
+		if len(turns) != 1 || turns[0].EndReason != TurnEndTimeout || turns[0].EndedBy != "" {
+			t.Errorf("Expected server timeout record, got %+v", turns)
+		}
+	})
+
+	t.Run("Paging", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		current := ""
+		for i := 0; i < 5; i++ {
+			current, _ = room.AdvanceTurn(current, "client1")
+		}
+
+		page := room.GetHistory(1, 2)
+		if page.Total != 4 || page.Offset != 1 || len(page.Turns) != 2 || page.Turns[0].Seq != 1 {
+			t.Errorf("Unexpected page: %+v", page)
+		}
+		if past := room.GetHistory(10, 2); len(past.Turns) != 0 || past.Total != 4 {
+			t.Errorf("Expected empty page past the end, got %+v", past)
+		}
+	})
+
+	t.Run("DropsOldestPastCap", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		current := ""
+		for i := 0; i < MaxTurnHistory+6; i++ {
+			current, _ = room.AdvanceTurn(current, "client1")
+		}
+
+		page := room.GetHistory(0, 1)
+		if page.Total != MaxTurnHistory || page.Turns[0].Seq != 5 {
+			t.Errorf("Expected %d turns starting at seq 5, got total %d starting at %d", MaxTurnHistory, page.Total, page.Turns[0].Seq)
+		}
+	})
+
+	t.Run("RecordsPhaseTime", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		room.MarkReady("client2")
+
+		turns := room.GetHistory(0, 0).Turns
+		if len(turns) != 1 || turns[0].ClientID != "client2" || turns[0].EndReason != TurnEndManual {
+			t.Errorf("Expected phase record for client2, got %+v", turns)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+// GetHost returns the client ID of the room's host (thread-safe read)
+// The host may be briefly absent while their reconnect window is open
+func (r *Room) GetHost() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.host
+}
+
+// IsHost reports whether the client is the room's host (thread-safe read)
+func (r *Room) IsHost(clientID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return clientID != "" && r.host == clientID
+}
+
+// TransferHost makes another player in the room the host (thread-safe)
+// Returns an error if the player is not seated in the room or already the host
+func (r *Room) TransferHost(clientID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Clients[clientID] == nil {
+		return errors.New("Player not found")
+	}
+	if r.host == clientID {
+		return errors.New("Player is already the host")
+	}
+	r.host = clientID
+	return nil
+}
+
+// PromoteHost hands the host role to the first seated player if previousHost is the host but no longer in the room (thread-safe)
+// Connected players are preferred over players who are away
+// If nobody is seated the room is left without a host, and the next player to join becomes host
+// Returns the new host and true if the role changed hands
+func (r *Room) PromoteHost(previousHost string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.host == "" || r.host != previousHost || r.Clients[previousHost] != nil {
+		return "", false // Someone else is host already, or the host is still here
+	}
+
+	seats := r.seatOrderLocked()
+	if len(seats) == 0 {
+		r.host = ""
+		return "", false
+	}
+	r.host = seats[0]
+	for _, clientID := range seats {
+		if !r.isAwayLocked(clientID) {
+			r.host = clientID
+			break
+		}
+	}
+	return r.host, true
+}
+
+// Kick bans a player or spectator from rejoining the room for the given duration (thread-safe)
+// The ban is on the client ID, which only its resume token reclaims (see Hub.handleRegister),
+// so others on the kicked player's network can still join
+// The caller removes them from the room (see Hub.RemoveClientFromRoom)
+// Returns the kicked client and when the ban ends in milliseconds
+func (r *Room) Kick(clientID string, ban time.Duration) (*Client, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if clientID == r.host {
+		return nil, 0, errors.New("The host cannot be kicked")
+	}
+	client := r.Clients[clientID]
+	if client == nil {
+		client = r.spectators[clientID]
+	}
+	if client == nil {
+		return nil, 0, errors.New("Player not found")
+	}
+
+	now := time.Now()
+	for bannedID, until := range r.bans {
+		if until <= now.UnixNano() {
+			delete(r.bans, bannedID) // Drop expired bans while we are here
+		}
+	}
+	until := now.Add(ban)
+	r.bans[clientID] = until.UnixNano()
+	return client, until.UnixMilli(), nil
+}
+
+// SetLocked locks or unlocks the room (thread-safe)
+// A locked room only lets back in players who have been seated in it before
+func (r *Room) SetLocked(locked bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locked = locked
+}
+
+// IsLocked reports whether the room is locked (thread-safe read)
+func (r *Room) IsLocked() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.locked
+}
+
+// CanJoin checks whether a client may join the room as a player or spectator (thread-safe read)
+// Returns an error if the client was kicked recently or the room is locked to newcomers
+func (r *Room) CanJoin(clientID string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if until, banned := r.bans[clientID]; banned && time.Now().UnixNano() < until {
+		return errors.New("You were kicked from this room")
+	}
+	if r.locked && r.members[clientID] == nil && r.spectators[clientID] == nil {
+		return errors.New("Room is locked")
+	}
+	return nil
+}
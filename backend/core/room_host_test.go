@@ -0,0 +1,193 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoomHost(t *testing.T) {
+	t.Run("FirstPlayerIsHost", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddSpectator(createTestClient("watcher", "TV", "#000000"))
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+		if room.GetHost() != "client1" || !room.IsHost("client1") || room.IsHost("client2") {
+			t.Errorf("Expected client1 to be host, got %s", room.GetHost())
+		}
+		if room.Snapshot().Host != "client1" {
+			t.Errorf("Expected host in snapshot, got %s", room.Snapshot().Host)
+		}
+	})
+
+	t.Run("TransferHost", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		room.AddSpectator(createTestClient("watcher", "TV", "#000000"))
+
+		if err := room.TransferHost("watcher"); err == nil || err.Error() != "Player not found" {
+			t.Errorf("Expected spectators to be refused, got %v", err)
+		}
+		if err := room.TransferHost("client1"); err == nil {
+			t.Error("Expected transfer to the current host to fail")
+		}
+		if err := room.TransferHost("client2"); err != nil || room.GetHost() != "client2" {
+			t.Errorf("Expected client2 to become host, got %s (%v)", room.GetHost(), err)
+		}
+	})
+
+	t.Run("PromoteHost", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+		if _, ok := room.PromoteHost("client1"); ok {
+			t.Error("Expected no promotion while the host is still in the room")
+		}
+		room.RemoveClient("client1")
+		if _, ok := room.PromoteHost("client2"); ok {
+			t.Error("Expected no promotion for a client that is not the host")
+		}
+		if host, ok := room.PromoteHost("client1"); !ok || host != "client2" {
+			t.Errorf("Expected client2 to be promoted, got %s", host)
+		}
+
+		// An empty room waits for the next player to take the role
+		room.RemoveClient("client2")
+		room.PromoteHost("client2")
+		room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+		if room.GetHost() != "client3" {
+			t.Errorf("Expected client3 to become host of the empty room, got %s", room.GetHost())
+		}
+	})
+
+	t.Run("Kick", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+		if _, _, err := room.Kick("client1", time.Minute); err == nil {
+			t.Error("Expected the host to be unkickable")
+		}
+		if _, _, err := room.Kick("nobody", time.Minute); err == nil || err.Error() != "Player not found" {
+			t.Errorf("Expected player not found, got %v", err)
+		}
+
+		kicked, until, err := room.Kick("client2", time.Minute)
+		if err != nil || kicked == nil || kicked.ClientID != "client2" {
+			t.Fatalf("Expected client2 to be kicked, got %v", err)
+		}
+		if !withinMs(until, time.Now().Add(time.Minute).UnixMilli()) {
+			t.Errorf("Expected ban to end in a minute, got %d", until)
+		}
+		room.RemoveClient("client2")
+		if err := room.CanJoin("client2"); err == nil || err.Error() != "You were kicked from this room" {
+			t.Errorf("Expected kicked player to be turned away, got %v", err)
+		}
+	})
+
+	t.Run("KickDoesNotBanNetwork", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		kicked := createTestClient("client2", "Bob", "#00FF00")
+		kicked.IP = "203.0.113.7"
+		room.AddClient(kicked)
+
+		room.Kick("client2", time.Minute)
+		room.RemoveClient("client2")
+
+		// Friends on the same home network are separate clients and are not affected
+		if err := room.CanJoin("client3"); err != nil {
+			t.Errorf("Expected a newcomer from the kicked player's network to be let in, got %v", err)
+		}
+	})
+
+	t.Run("BanExpires", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddSpectator(createTestClient("watcher", "TV", "#000000"))
+
+		room.Kick("watcher", 0)
+		room.RemoveClient("watcher")
+		if err := room.CanJoin("watcher"); err != nil {
+			t.Errorf("Expected an expired ban to let the client back, got %v", err)
+		}
+	})
+
+	t.Run("Lock", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		room.RemoveClient("client2")
+
+		room.SetLocked(true)
+		if !room.IsLocked() || !room.Snapshot().Locked {
+			t.Error("Expected room to be locked")
+		}
+		if err := room.CanJoin("newcomer"); err == nil || err.Error() != "Room is locked" {
+			t.Errorf("Expected newcomers to be turned away, got %v", err)
+		}
+		if err := room.CanJoin("client2"); err != nil {
+			t.Errorf("Expected a former player to be let back in, got %v", err)
+		}
+
+		room.SetLocked(false)
+		if err := room.CanJoin("newcomer"); err != nil {
+			t.Errorf("Expected unlocked room to accept newcomers, got %v", err)
+		}
+	})
+}
+
+func TestHubHostPromotion(t *testing.T) {
+	setup := func() (*Hub, *Room, *[]string) {
+		hub := NewHub()
+		room := NewRoom("ROOM123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		addRoomForTest(hub, "ROOM123", room)
+
+		var promoted []string
+		hub.OnHostChanged = func(roomID, hostID, previousHostID string) {
+			promoted = append(promoted, previousHostID+"->"+hostID)
+		}
+		return hub, room, &promoted
+	}
+
+	t.Run("PromotesWhenHostLeaves", func(t *testing.T) {
+		hub, room, promoted := setup()
+		hub.RemoveClientFromRoom("ROOM123", "client1", "intentional leave")
+
+		if room.GetHost() != "client2" || len(*promoted) != 1 || (*promoted)[0] != "client1->client2" {
+			t.Errorf("Expected client2 to be promoted, got host %s and %v", room.GetHost(), *promoted)
+		}
+	})
+
+	t.Run("WaitsForDisconnectedHost", func(t *testing.T) {
+		hub, room, promoted := setup()
+		addDisconnectedClientForTest(hub, "client1", &DisconnectedClient{
+			ClientID:       "client1",
+			LastRoomID:     "ROOM123",
+			DisconnectedAt: time.Now(),
+		})
+		hub.RemoveClientFromRoom("ROOM123", "client1", "disconnect")
+		if room.GetHost() != "client1" || len(*promoted) != 0 {
+			t.Fatalf("Expected the disconnected host to keep the role, got %s", room.GetHost())
+		}
+
+		hub.cleanupDisconnectedClients()
+		if room.GetHost() != "client1" {
+			t.Fatalf("Expected the host to keep the role within the reconnect window, got %s", room.GetHost())
+		}
+
+		addDisconnectedClientForTest(hub, "client1", &DisconnectedClient{
+			ClientID:       "client1",
+			LastRoomID:     "ROOM123",
+			DisconnectedAt: time.Now().Add(-DisconnectedClientTTL - time.Minute),
+		})
+		hub.cleanupDisconnectedClients()
+		if room.GetHost() != "client2" || len(*promoted) != 1 {
+			t.Errorf("Expected client2 to be promoted once the window ran out, got %s", room.GetHost())
+		}
+	})
+}
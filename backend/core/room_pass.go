@@ -0,0 +1,57 @@
+package core
+
+import "errors"
+
+// PassTurn marks the player as passed for the rest of the round and advances to the next player who has not passed (thread-safe)
+// clientID must have the current turn (the pass is recorded as a manual end of their turn)
+// Returns true if everyone has now passed, which ends the turn and the round and clears every pass
+// Returns an error from checkTurnChangeLocked if the turn may not change
+func (r *Room) PassTurn(clientID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTurnChangeLocked(clientID); err != nil {
+		return false, err
+	}
+	if r.CurrentTurn == "" || r.CurrentTurn != clientID {
+		return false, errors.New("You can only pass on your own turn")
+	}
+
+	r.memberLocked(clientID).Passed = true
+
+	if r.allPassedLocked() {
+		r.clearTurnLocked(turnEnd{by: clientID, reason: TurnEndManual})
+		r.completeRoundLocked()
+		return true, nil
+	}
+
+	r.startTurnLocked(r.nextSeatLocked(clientID), turnEnd{by: clientID, reason: TurnEndManual})
+	return false, nil
+}
+
+// anyPassedLocked reports whether any seated player has passed this round
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) anyPassedLocked() bool {
+	for _, clientID := range r.seatOrderLocked() {
+		if member := r.members[clientID]; member != nil && member.Passed {
+			return true
+		}
+	}
+	return false
+}
+
+// allPassedLocked reports whether every seated player has passed this round
+// Returns false for an empty room
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) allPassedLocked() bool {
+	seats := r.seatOrderLocked()
+	if len(seats) == 0 {
+		return false
+	}
+	for _, clientID := range seats {
+		if member := r.members[clientID]; member == nil || !member.Passed {
+			return false
+		}
+	}
+	return true
+}
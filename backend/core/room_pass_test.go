@@ -0,0 +1,83 @@
+package core
+
+import "testing"
+
+func TestRoomPass(t *testing.T) {
+	t.Run("RequiresCurrentTurn", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.SetCurrentTurn("", "client1", "")
+		if _, err := room.PassTurn("client2"); err == nil {
+			t.Error("Expected pass out of turn to fail")
+		}
+	})
+
+	t.Run("AdvancesToNextUnpassedPlayer", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.SetCurrentTurn("", "client2", "")
+		room.PassTurn("client2")
+		if room.GetCurrentTurn() != "client3" {
+			t.Fatalf("Expected client3, got %s", room.GetCurrentTurn())
+		}
+
+		// client2 is skipped from now on
+		room.AdvanceTurn("client3", "")
+		if next, _ := room.AdvanceTurn("client1", ""); next != "client3" {
+			t.Errorf("Expected passed client2 to be skipped, got %s", next)
+		}
+	})
+
+	t.Run("PeerInfoShowsPassed", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.SetCurrentTurn("", "client1", "")
+		room.PassTurn("client1")
+
+		for _, peer := range room.ListPeerInfo() {
+			if peer.Passed != (peer.ClientID == "client1") {
+				t.Errorf("Unexpected passed state for %s: %v", peer.ClientID, peer.Passed)
+			}
+		}
+	})
+
+	t.Run("RoundLastsUntilEveryonePassed", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.SetCurrentTurn("", "client1", "")
+		room.PassTurn("client1")        // client2's turn
+		room.AdvanceTurn("client2", "") // client3's turn - everyone has played
+		room.AdvanceTurn("client3", "") // client2 again, client1 is skipped
+		if completed := room.TakeCompletedRounds(); len(completed) != 0 {
+			t.Fatalf("Expected round to continue while players are still bidding, got %+v", completed)
+		}
+
+		room.PassTurn("client2")
+		allPassed, err := room.PassTurn("client3")
+		if err != nil || !allPassed {
+			t.Fatalf("Expected last pass to end the round (err=%v)", err)
+		}
+		if completed := room.TakeCompletedRounds(); len(completed) != 1 {
+			t.Fatalf("Expected 1 completed round, got %d", len(completed))
+		}
+		if room.GetCurrentTurn() != "" {
+			t.Errorf("Expected no active turn after everyone passed, got %s", room.GetCurrentTurn())
+		}
+		for _, peer := range room.ListPeerInfo() {
+			if peer.Passed {
+				t.Errorf("Expected passes to be cleared, %s still passed", peer.ClientID)
+			}
+		}
+	})
+
+	t.Run("LeavingLastUnpassedPlayerEndsRound", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.SetCurrentTurn("", "client1", "")
+		room.PassTurn("client1")
+		room.PassTurn("client2")
+
+		hadTurn, _ := room.RemoveClient("client3")
+		if !hadTurn {
+			t.Error("Expected RemoveClient to report the turn ended")
+		}
+		if completed := room.TakeCompletedRounds(); len(completed) != 1 {
+			t.Errorf("Expected the round to complete, got %+v", completed)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+// Pause freezes the game clock (thread-safe)
+// The active turn stops accumulating time until Resume is called
+// Returns the pause time in milliseconds, or an error if the game is already paused
+func (r *Room) Pause(clientID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return 0, err
+	}
+
+	if r.pausedAt != nil {
+		return 0, errors.New("Game is already paused")
+	}
+
+	now := time.Now().UnixNano()
+	r.pausedAt = &now
+	r.pausedBy = clientID
+	r.turnGen++ // Timers armed before the pause belong to the old timing
+	return now / int64(time.Millisecond), nil
+}
+
+// Resume restarts the game clock (thread-safe)
+// The active turn's (and simultaneous phase's) start time moves forward by the paused duration so paused time is never counted
+// Returns the resume time and paused duration in milliseconds, or an error if the game is not paused
+func (r *Room) Resume() (int64, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return 0, 0, err
+	}
+
+	if r.pausedAt == nil {
+		return 0, 0, errors.New("Game is not paused")
+	}
+
+	now := time.Now().UnixNano()
+	pausedFor := now - *r.pausedAt
+	if r.TurnStartTime != nil {
+		shifted := *r.TurnStartTime + pausedFor
+		r.TurnStartTime = &shifted
+	}
+	if r.phase != nil {
+		r.phase.start += pausedFor
+	}
+	for i := range r.undo {
+		if start := r.undo[i].turnStartTime; start != nil {
+			shifted := *start + pausedFor
+			r.undo[i].turnStartTime = &shifted
+		}
+	}
+	r.pausedAt = nil
+	r.pausedBy = ""
+	r.turnGen++
+	return now / int64(time.Millisecond), pausedFor / int64(time.Millisecond), nil
+}
+
+// IsPaused reports whether the game clock is paused (thread-safe read)
+func (r *Room) IsPaused() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pausedAt != nil
+}
+
+// GetPausedAt returns when the game was paused in milliseconds, or 0 if not paused (thread-safe read)
+func (r *Room) GetPausedAt() int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.pausedAt == nil {
+		return 0
+	}
+	return *r.pausedAt / int64(time.Millisecond)
+}
+
+// nowLocked returns the current game time in nanoseconds
+// While paused, game time stands still at the moment of the pause
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) nowLocked() int64 {
+	if r.pausedAt != nil {
+		return *r.pausedAt
+	}
+	return time.Now().UnixNano()
+}
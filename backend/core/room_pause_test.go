@@ -0,0 +1,155 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// backdatePause pretends the game was paused the given time ago
+func backdatePause(room *Room, elapsed time.Duration) {
+	room.mu.Lock()
+	defer room.mu.Unlock()
+	pausedAt := time.Now().Add(-elapsed).UnixNano()
+	room.pausedAt = &pausedAt
+}
+
+func TestRoomPause(t *testing.T) {
+	t.Run("PauseAndResume", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+
+		if room.IsPaused() {
+			t.Fatal("Expected new room to be running")
+		}
+		if _, err := room.Pause("client1"); err != nil {
+			t.Fatalf("Expected Pause to succeed: %v", err)
+		}
+		if !room.IsPaused() || room.GetPausedAt() == 0 {
+			t.Error("Expected room to be paused")
+		}
+		if _, err := room.Pause("client1"); err == nil {
+			t.Error("Expected second Pause to fail")
+		}
+
+		if _, _, err := room.Resume(); err != nil {
+			t.Fatalf("Expected Resume to succeed: %v", err)
+		}
+		if room.IsPaused() {
+			t.Error("Expected room to be running after Resume")
+		}
+		if _, _, err := room.Resume(); err == nil {
+			t.Error("Expected Resume of a running game to fail")
+		}
+	})
+
+	t.Run("PausedTimeNotCounted", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		client := createTestClient("client1", "Alice", "#FF0000")
+		room.AddClient(client)
+		room.SetCurrentTurn("", "client1", "")
+
+		// The turn started 12s ago and the game has been paused for the last 10s
+		backdateTurn(room, 12*time.Second)
+		room.Pause("client1")
+		backdatePause(room, 10*time.Second)
+
+		_, pausedMs, err := room.Resume()
+		if err != nil {
+			t.Fatalf("Expected Resume to succeed: %v", err)
+		}
+		if !withinMs(pausedMs, 10000) {
+			t.Errorf("Expected ~10000ms paused, got %d", pausedMs)
+		}
+
+		room.ClearCurrentTurn("")
+		if !withinMs(client.TotalTurnTime, 2000) {
+			t.Errorf("Expected ~2000ms turn time, got %d", client.TotalTurnTime)
+		}
+	})
+
+	t.Run("EndTurnWhilePaused", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		client := createTestClient("client1", "Alice", "#FF0000")
+		room.AddClient(client)
+		room.SetCurrentTurn("", "client1", "")
+
+		backdateTurn(room, 5*time.Second)
+		room.Pause("client1")
+		backdatePause(room, 3*time.Second)
+
+		// Nobody can end the turn mid-pause, but the player can leave - only time before the pause counts
+		if err := room.ClearCurrentTurn("client1"); err != ErrGamePaused {
+			t.Errorf("Expected ending the turn to be refused while paused, got %v", err)
+		}
+		room.RemoveClient("client1")
+		if !withinMs(client.TotalTurnTime, 2000) {
+			t.Errorf("Expected ~2000ms turn time, got %d", client.TotalTurnTime)
+		}
+	})
+
+	t.Run("TurnChangesRefusedWhilePaused", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		room.SetCurrentTurn("", "client1", "")
+		room.Pause("client1")
+
+		if err := room.SetCurrentTurn("client1", "client2", "client1"); err != ErrGamePaused {
+			t.Errorf("Expected SetCurrentTurn to be refused, got %v", err)
+		}
+		if _, err := room.AdvanceTurn("client1", "client1"); err != ErrGamePaused {
+			t.Errorf("Expected AdvanceTurn to be refused, got %v", err)
+		}
+		if _, err := room.PassTurn("client1"); err != ErrGamePaused {
+			t.Errorf("Expected PassTurn to be refused, got %v", err)
+		}
+		if room.GetCurrentTurn() != "client1" {
+			t.Errorf("Expected the turn to stay with client1, got %s", room.GetCurrentTurn())
+		}
+	})
+
+	t.Run("NoTimersWhilePaused", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.SetSettings(RoomSettings{TurnTimeLimitMs: 60000})
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.SetCurrentTurn("", "client1", "")
+
+		if _, ok := room.turnTiming(); !ok {
+			t.Fatal("Expected timers for a running timed turn")
+		}
+		room.Pause("client1")
+		if _, ok := room.turnTiming(); ok {
+			t.Error("Expected no timers while paused")
+		}
+		room.Resume()
+		if _, ok := room.turnTiming(); !ok {
+			t.Error("Expected timers after resume")
+		}
+	})
+
+	t.Run("PauseInvalidatesArmedTimers", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.SetCurrentTurn("", "client1", "")
+
+		gen := room.turnGen
+		room.Pause("client1")
+		if room.isTurnGeneration(gen) {
+			t.Error("Expected pause to invalidate timers armed before it")
+		}
+	})
+
+	t.Run("SnapshotIncludesPausedAt", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+
+		if room.Snapshot().PausedAt != nil {
+			t.Error("Expected no paused_at while running")
+		}
+		pausedAt, _ := room.Pause("client1")
+		snapshot := room.Snapshot()
+		if snapshot.PausedAt == nil || *snapshot.PausedAt != pausedAt {
+			t.Errorf("Expected paused_at %d, got %v", pausedAt, snapshot.PausedAt)
+		}
+	})
+}
@@ -0,0 +1,226 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+// ReadyPlayer is a player who finished acting in a simultaneous phase
+type ReadyPlayer struct {
+	ClientID string `json:"client_id"`
+	TimeMs   int64  `json:"time_ms"` // Time the player took in the phase (in milliseconds)
+}
+
+// PhaseState is a simultaneous phase as sent to clients
+type PhaseState struct {
+	StartTime int64         `json:"start_time"` // Unix timestamp in milliseconds when the phase started
+	Waiting   []string      `json:"waiting"`    // clientIDs still acting, in seating order
+	Ready     []ReadyPlayer `json:"ready"`      // Players who marked ready, in the order they did
+}
+
+// phase is a simultaneous turn phase where every player acts at once
+type phase struct {
+	start     int64         // Unix timestamp in nanoseconds when the phase started (shifted by pauses)
+	startedAt int64         // Wall-clock Unix timestamp in nanoseconds when the phase started
+	players   []string      // clientIDs taking part, in seating order at the start of the phase
+	ready     []ReadyPlayer // Players who marked ready, in the order they did
+}
+
+// StartPhase starts a simultaneous phase with every connected seated player active (thread-safe)
+// Away players sit the phase out, so they cannot hold it up
+// startedBy is the client that started the phase
+// Returns an error from checkTurnChangeLocked if the turn may not change, or an error if the room
+// is not in simultaneous mode or a phase is already running
+func (r *Room) StartPhase(startedBy string) (PhaseState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTurnChangeLocked(startedBy); err != nil {
+		return PhaseState{}, err
+	}
+	if r.settings.TurnMode != TurnModeSimultaneous {
+		return PhaseState{}, errors.New("Room is not in simultaneous mode")
+	}
+	if r.phase != nil {
+		return PhaseState{}, errors.New("A phase is already in progress")
+	}
+
+	seats := r.seatOrderLocked()
+	if len(seats) == 0 {
+		return PhaseState{}, errors.New("No players in the room")
+	}
+	players := make([]string, 0, len(seats))
+	for _, clientID := range seats {
+		if !r.isAwayLocked(clientID) {
+			players = append(players, clientID)
+		}
+	}
+	if len(players) == 0 {
+		return PhaseState{}, errors.New("No connected players in the room")
+	}
+
+	r.phase = &phase{start: r.nowLocked(), startedAt: time.Now().UnixNano(), players: players}
+	for _, clientID := range players {
+		member := r.memberLocked(clientID)
+		if member.RoundTurns == 0 && member.RoundTimeMs == 0 {
+			r.roundPlayers = append(r.roundPlayers, clientID)
+		}
+		member.RoundTurns++
+	}
+	return r.phaseStateLocked(), nil
+}
+
+// MarkReady records that a player finished acting in the current phase (thread-safe)
+// The player's time in the phase is added to their TotalTurnTime
+// Returns the updated phase and true if this was the last player, which ends the phase and the round
+func (r *Room) MarkReady(clientID string) (PhaseState, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.phase == nil {
+		return PhaseState{}, false, errors.New("No phase in progress")
+	}
+	if !r.isWaitingLocked(clientID) {
+		for _, ready := range r.phase.ready {
+			if ready.ClientID == clientID {
+				return PhaseState{}, false, errors.New("Already marked ready")
+			}
+		}
+		return PhaseState{}, false, errors.New("Not taking part in this phase")
+	}
+
+	durationMs := r.chargePhaseTimeLocked(clientID, turnEnd{by: clientID, reason: TurnEndManual})
+	r.phase.ready = append(r.phase.ready, ReadyPlayer{ClientID: clientID, TimeMs: durationMs})
+	if !r.finishPhaseIfReadyLocked() {
+		return r.phaseStateLocked(), false, nil
+	}
+	// The caller announces the ended phase, so it is not left for TakeCompletedPhase
+	completed := *r.completedPhase
+	r.completedPhase = nil
+	return completed, true, nil
+}
+
+// GetPhase returns the current simultaneous phase, or nil if none is running (thread-safe read)
+func (r *Room) GetPhase() *PhaseState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.phase == nil {
+		return nil
+	}
+	state := r.phaseStateLocked()
+	return &state
+}
+
+// TakeCompletedPhase returns the phase that ended since the last call and forgets it (thread-safe)
+// Returns nil if no phase ended (used to announce phases completed by a player leaving)
+func (r *Room) TakeCompletedPhase() *PhaseState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	completed := r.completedPhase
+	r.completedPhase = nil
+	return completed
+}
+
+// isWaitingLocked reports whether clientID is still acting in the current phase
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) isWaitingLocked(clientID string) bool {
+	if r.phase == nil {
+		return false
+	}
+	for _, player := range r.phase.players {
+		if player == clientID {
+			for _, ready := range r.phase.ready {
+				if ready.ClientID == clientID {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// chargePhaseTimeLocked adds the time a player has spent in the current phase to their totals
+// and records it in the turn history
+// Returns the time charged in milliseconds
+// MUST be called with r.mu.Lock() held
+func (r *Room) chargePhaseTimeLocked(clientID string, end turnEnd) int64 {
+	durationMs := (r.nowLocked() - r.phase.start) / int64(time.Millisecond)
+	if client := r.Clients[clientID]; client != nil {
+		client.TotalTurnTime += durationMs
+	}
+	r.memberLocked(clientID).RoundTimeMs += durationMs
+	r.appendHistoryLocked(clientID, r.phase.startedAt, durationMs, end)
+	return durationMs
+}
+
+// finishPhaseIfReadyLocked ends the phase and completes the round once no connected player is still acting
+// Away players still acting are dropped from the phase, charged up to now as if they had left
+// Returns true if the phase ended
+// MUST be called with r.mu.Lock() held
+func (r *Room) finishPhaseIfReadyLocked() bool {
+	var away []string
+	for _, player := range r.phase.players {
+		if !r.isWaitingLocked(player) {
+			continue
+		}
+		if !r.isAwayLocked(player) {
+			return false
+		}
+		away = append(away, player)
+	}
+	for _, player := range away {
+		r.chargePhaseTimeLocked(player, turnEnd{by: player, reason: TurnEndDisconnect})
+		r.dropPhasePlayerLocked(player)
+	}
+
+	state := r.phaseStateLocked()
+	r.completedPhase = &state
+	r.phase = nil
+	r.completeRoundLocked()
+	return true
+}
+
+// removeFromPhaseLocked drops a leaving player from the current phase
+// Time they spent acting is still charged; the phase ends if everyone left is ready
+// Returns true if the phase was waiting on the player
+// MUST be called with r.mu.Lock() held
+func (r *Room) removeFromPhaseLocked(clientID string) bool {
+	if !r.isWaitingLocked(clientID) {
+		return false
+	}
+
+	r.chargePhaseTimeLocked(clientID, turnEnd{by: clientID, reason: TurnEndDisconnect})
+	r.dropPhasePlayerLocked(clientID)
+	r.finishPhaseIfReadyLocked()
+	return true
+}
+
+// dropPhasePlayerLocked takes a player out of the current phase
+// MUST be called with r.mu.Lock() held
+func (r *Room) dropPhasePlayerLocked(clientID string) {
+	for i, player := range r.phase.players {
+		if player == clientID {
+			r.phase.players = append(r.phase.players[:i:i], r.phase.players[i+1:]...)
+			return
+		}
+	}
+}
+
+// phaseStateLocked builds the client view of the current phase
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) phaseStateLocked() PhaseState {
+	state := PhaseState{
+		StartTime: r.phase.start / int64(time.Millisecond),
+		Waiting:   []string{},
+		Ready:     append([]ReadyPlayer{}, r.phase.ready...),
+	}
+	for _, player := range r.phase.players {
+		if r.isWaitingLocked(player) {
+			state.Waiting = append(state.Waiting, player)
+		}
+	}
+	return state
+}
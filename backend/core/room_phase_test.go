@@ -0,0 +1,227 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func setupPhaseRoom(t *testing.T) *Room {
+	t.Helper()
+	room := NewRoom("TEST123")
+	if err := room.SetSettings(RoomSettings{TurnMode: TurnModeSimultaneous}); err != nil {
+		t.Fatalf("Invalid settings: %v", err)
+	}
+	room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+	room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+	room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+	return room
+}
+
+// backdatePhase pretends the active phase started the given time ago
+func backdatePhase(room *Room, elapsed time.Duration) {
+	room.mu.Lock()
+	defer room.mu.Unlock()
+	room.phase.start = time.Now().Add(-elapsed).UnixNano()
+}
+
+func TestRoomPhase(t *testing.T) {
+	t.Run("RequiresSimultaneousMode", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		if _, err := room.StartPhase(""); err == nil {
+			t.Error("Expected StartPhase to fail in sequential mode")
+		}
+	})
+
+	t.Run("EveryoneStartsWaiting", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		phase, err := room.StartPhase("")
+		if err != nil {
+			t.Fatalf("Expected StartPhase to succeed: %v", err)
+		}
+		if len(phase.Waiting) != 3 || len(phase.Ready) != 0 || phase.StartTime == 0 {
+			t.Errorf("Unexpected phase: %+v", phase)
+		}
+		if _, err := room.StartPhase(""); err == nil {
+			t.Error("Expected second StartPhase to fail while a phase is running")
+		}
+		if room.Snapshot().Phase == nil {
+			t.Error("Expected snapshot to include the phase")
+		}
+	})
+
+	t.Run("MarkReadyFillsReadySet", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+
+		phase, allReady, err := room.MarkReady("client2")
+		if err != nil || allReady {
+			t.Fatalf("Expected first MarkReady to succeed without ending the phase (err=%v)", err)
+		}
+		if len(phase.Ready) != 1 || phase.Ready[0].ClientID != "client2" {
+			t.Errorf("Expected client2 ready, got %+v", phase.Ready)
+		}
+		if len(phase.Waiting) != 2 || phase.Waiting[0] != "client1" || phase.Waiting[1] != "client3" {
+			t.Errorf("Expected [client1 client3] waiting, got %v", phase.Waiting)
+		}
+		if _, _, err := room.MarkReady("client2"); err == nil {
+			t.Error("Expected second MarkReady from the same player to fail")
+		}
+
+		room.MarkReady("client1")
+		phase, allReady, err = room.MarkReady("client3")
+		if err != nil || !allReady {
+			t.Fatalf("Expected last MarkReady to end the phase (err=%v)", err)
+		}
+		if len(phase.Ready) != 3 || len(phase.Waiting) != 0 {
+			t.Errorf("Unexpected final phase: %+v", phase)
+		}
+		if room.GetPhase() != nil {
+			t.Error("Expected no phase after everyone is ready")
+		}
+		if _, _, err := room.MarkReady("client1"); err == nil {
+			t.Error("Expected MarkReady to fail with no phase running")
+		}
+	})
+
+	t.Run("TimeGoesIntoTotalTurnTime", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.SetSettings(RoomSettings{TurnMode: TurnModeSimultaneous})
+		client1 := createTestClient("client1", "Alice", "#FF0000")
+		client2 := createTestClient("client2", "Bob", "#00FF00")
+		room.AddClient(client1)
+		room.AddClient(client2)
+
+		room.StartPhase("")
+		backdatePhase(room, 2*time.Second)
+		room.MarkReady("client1")
+		backdatePhase(room, 5*time.Second)
+		room.MarkReady("client2")
+
+		if !withinMs(client1.TotalTurnTime, 2000) || !withinMs(client2.TotalTurnTime, 5000) {
+			t.Errorf("Expected ~2000ms and ~5000ms, got %d and %d", client1.TotalTurnTime, client2.TotalTurnTime)
+		}
+	})
+
+	t.Run("CompletesRound", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		room.MarkReady("client1")
+		room.MarkReady("client2")
+		room.MarkReady("client3")
+
+		completed := room.TakeCompletedRounds()
+		if len(completed) != 1 || len(completed[0].Players) != 3 {
+			t.Fatalf("Expected one completed round with 3 players, got %+v", completed)
+		}
+		for _, player := range completed[0].Players {
+			if player.Turns != 1 {
+				t.Errorf("Expected 1 turn for %s, got %d", player.ClientID, player.Turns)
+			}
+		}
+		if room.GetRound() != 2 {
+			t.Errorf("Expected round 2, got %d", room.GetRound())
+		}
+	})
+
+	t.Run("LateJoinerNotWaitedOn", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		room.AddClient(createTestClient("client4", "Dave", "#FFFFFF"))
+
+		if _, _, err := room.MarkReady("client4"); err == nil {
+			t.Error("Expected late joiner to be rejected")
+		}
+		if phase := room.GetPhase(); len(phase.Waiting) != 3 {
+			t.Errorf("Expected 3 players waiting, got %v", phase.Waiting)
+		}
+	})
+
+	t.Run("LeavingPlayerCanEndPhase", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		room.MarkReady("client1")
+		room.MarkReady("client2")
+
+		hadTurn, _ := room.RemoveClient("client3")
+		if !hadTurn {
+			t.Error("Expected RemoveClient to report the phase was waiting on client3")
+		}
+		completed := room.TakeCompletedPhase()
+		if completed == nil || len(completed.Ready) != 2 {
+			t.Fatalf("Expected completed phase with 2 ready players, got %+v", completed)
+		}
+		if room.GetPhase() != nil {
+			t.Error("Expected phase to have ended")
+		}
+	})
+
+	t.Run("AwayPlayerSitsPhaseOut", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.MarkAway(room.Clients["client3"])
+
+		phase, err := room.StartPhase("")
+		if err != nil {
+			t.Fatalf("Expected StartPhase to succeed: %v", err)
+		}
+		if len(phase.Waiting) != 2 {
+			t.Fatalf("Expected only connected players waiting, got %v", phase.Waiting)
+		}
+		room.MarkReady("client1")
+		if _, allReady, _ := room.MarkReady("client2"); !allReady {
+			t.Error("Expected the phase to end without the away player")
+		}
+	})
+
+	t.Run("AwayPlayerDoesNotBlockPhase", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		room.MarkReady("client1")
+		room.MarkAway(room.Clients["client3"])
+
+		phase, allReady, err := room.MarkReady("client2")
+		if err != nil || !allReady {
+			t.Fatalf("Expected the last connected player to end the phase, got %v %v", allReady, err)
+		}
+		if len(phase.Waiting) != 0 || len(phase.Ready) != 2 {
+			t.Errorf("Expected the away player dropped from the phase, got %+v", phase)
+		}
+	})
+
+	t.Run("GoingAwayCanEndPhase", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		room.MarkReady("client1")
+		room.MarkReady("client2")
+
+		if _, phaseEnded, ok := room.MarkAway(room.Clients["client3"]); !ok || !phaseEnded {
+			t.Fatalf("Expected MarkAway to end the phase, got %v %v", phaseEnded, ok)
+		}
+		if completed := room.TakeCompletedPhase(); completed == nil || len(completed.Ready) != 2 {
+			t.Errorf("Expected completed phase with 2 ready players, got %+v", completed)
+		}
+	})
+
+	t.Run("ReconnectedPlayerStillActs", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		room.MarkReady("client1")
+		room.MarkAway(room.Clients["client3"])
+		room.Reconnect(createTestClient("client3", "Carol", "#0000FF"))
+
+		if _, allReady, _ := room.MarkReady("client2"); allReady {
+			t.Error("Expected the phase to wait on the reconnected player")
+		}
+		if _, allReady, err := room.MarkReady("client3"); err != nil || !allReady {
+			t.Errorf("Expected the reconnected player to end the phase, got %v %v", allReady, err)
+		}
+	})
+
+	t.Run("TurnModeLockedDuringPhase", func(t *testing.T) {
+		room := setupPhaseRoom(t)
+		room.StartPhase("")
+		if err := room.SetSettings(RoomSettings{TurnMode: TurnModeSequential}); err == nil {
+			t.Error("Expected turn mode change to fail during a phase")
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+const (
+	// MinPINLength and MaxPINLength bound the length of a room PIN (digits only)
+	MinPINLength = 4
+	MaxPINLength = 8
+)
+
+// ValidatePIN checks that a room PIN is well formed
+// An empty PIN is valid and means the room is open
+func ValidatePIN(pin string) error {
+	if pin == "" {
+		return nil
+	}
+	if len(pin) < MinPINLength || len(pin) > MaxPINLength {
+		return errors.New("PIN must be 4 to 8 digits")
+	}
+	for _, c := range pin {
+		if c < '0' || c > '9' {
+			return errors.New("PIN must be 4 to 8 digits")
+		}
+	}
+	return nil
+}
+
+// SetPIN protects the room with a PIN (thread-safe)
+// Clients already in the room are admitted, so they are never asked for it
+func (r *Room) SetPIN(pin string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pin = pin
+	for clientID := range r.Clients {
+		r.pinAdmitted[clientID] = true
+	}
+	for clientID := range r.spectators {
+		r.pinAdmitted[clientID] = true
+	}
+}
+
+// HasPIN reports whether joining the room requires a PIN (thread-safe read)
+func (r *Room) HasPIN() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pin != ""
+}
+
+// NeedsPIN reports whether a client must enter the PIN to join (thread-safe read)
+// Clients that entered it before (or were in the room when it was set) are let back in with their client ID
+func (r *Room) NeedsPIN(clientID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pin != "" && !r.pinAdmitted[clientID]
+}
+
+// AdmitWithPIN checks the PIN and remembers the client if it matches (thread-safe)
+// Returns false if the PIN is wrong
+func (r *Room) AdmitWithPIN(clientID, pin string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pin == "" {
+		return true
+	}
+	// Constant-time compare so response timing does not leak how much of the PIN was right
+	if subtle.ConstantTimeCompare([]byte(r.pin), []byte(pin)) != 1 {
+		return false
+	}
+	r.pinAdmitted[clientID] = true
+	return true
+}
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRoomPIN(t *testing.T) {
+	t.Run("ValidatePIN", func(t *testing.T) {
+		tests := []struct {
+			pin   string
+			valid bool
+		}{
+			{"", true},
+			{"1234", true},
+			{"12345678", true},
+			{"123", false},
+			{"123456789", false},
+			{"12a4", false},
+		}
+		for _, tt := range tests {
+			if err := ValidatePIN(tt.pin); (err == nil) != tt.valid {
+				t.Errorf("ValidatePIN(%q) = %v, want valid=%v", tt.pin, err, tt.valid)
+			}
+		}
+	})
+
+	t.Run("OpenRoomNeedsNoPIN", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		if room.HasPIN() || room.NeedsPIN("client1") {
+			t.Error("Expected an open room")
+		}
+	})
+
+	t.Run("MembersAreAdmitted", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.SetPIN("1234")
+
+		if room.NeedsPIN("client1") {
+			t.Error("Expected the creator not to need the PIN")
+		}
+		if !room.NeedsPIN("client2") {
+			t.Error("Expected a newcomer to need the PIN")
+		}
+	})
+
+	t.Run("AdmittedClientSkipsPINOnRejoin", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.SetPIN("1234")
+
+		if room.AdmitWithPIN("client2", "9999") {
+			t.Error("Expected wrong PIN to be refused")
+		}
+		if !room.AdmitWithPIN("client2", "1234") {
+			t.Fatal("Expected right PIN to be accepted")
+		}
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		room.RemoveClient("client2")
+		if room.NeedsPIN("client2") {
+			t.Error("Expected an admitted client to rejoin without the PIN")
+		}
+	})
+}
+
+func TestHubCheckRoomPIN(t *testing.T) {
+	setup := func() (*Hub, *Room) {
+		hub := NewHub()
+		room := NewRoom("TEST123")
+		room.SetPIN("1234")
+		hub.AddRoom(room.ID, room)
+		return hub, room
+	}
+	guesser := func(clientID, ip string) *Client {
+		client := createTestClient(clientID, "Guesser", "#FF0000")
+		client.IP = ip
+		return client
+	}
+
+	t.Run("ThrottledPerIPAndRoom", func(t *testing.T) {
+		hub, room := setup()
+		defer hub.Shutdown()
+
+		for i := 0; i < MaxPINFailuresPerRoom; i++ {
+			if err := hub.CheckRoomPIN(room, guesser("client1", "10.0.0.1"), "0000"); err == nil {
+				t.Fatal("Expected wrong PIN to be refused")
+			}
+		}
+		if err := hub.CheckRoomPIN(room, guesser("client2", "10.0.0.1"), "1234"); err == nil {
+			t.Error("Expected the IP to be throttled for the room")
+		}
+		if err := hub.CheckRoomPIN(room, guesser("client3", "10.0.0.2"), "1234"); err != nil {
+			t.Errorf("Expected another IP to get in, got %v", err)
+		}
+	})
+
+	t.Run("GuessersDoNotLockOthersOut", func(t *testing.T) {
+		hub, room := setup()
+		defer hub.Shutdown()
+
+		for i := 0; i < 4*MaxPINFailuresPerRoom; i++ {
+			// Many IPs guessing wrong only throttle themselves
+			hub.CheckRoomPIN(room, guesser("client1", fmt.Sprintf("10.0.1.%d", i)), "0000")
+		}
+		if err := hub.CheckRoomPIN(room, guesser("client2", "10.0.2.1"), "1234"); err != nil {
+			t.Errorf("Expected a newcomer with the right PIN to get in, got %v", err)
+		}
+	})
+
+	t.Run("OtherRoomsNotThrottled", func(t *testing.T) {
+		hub, room := setup()
+		defer hub.Shutdown()
+
+		// Wrong PINs at other tables on a shared network do not lock the network out of this room
+		for i := 0; i < 4*MaxPINFailuresPerRoom; i++ {
+			other := NewRoom(fmt.Sprintf("ROOM%02d", i))
+			other.SetPIN("1234")
+			hub.CheckRoomPIN(other, guesser("client1", "10.0.0.1"), "0000")
+		}
+		if err := hub.CheckRoomPIN(room, guesser("client2", "10.0.0.1"), "1234"); err != nil {
+			t.Errorf("Expected the right PIN to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("MissingPINIsNotAFailure", func(t *testing.T) {
+		hub, room := setup()
+		defer hub.Shutdown()
+
+		for i := 0; i < MaxPINFailuresPerRoom+1; i++ {
+			hub.CheckRoomPIN(room, guesser("client1", "10.0.0.1"), "")
+		}
+		if err := hub.CheckRoomPIN(room, guesser("client1", "10.0.0.1"), "1234"); err != nil {
+			t.Errorf("Expected right PIN to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("FailuresExpire", func(t *testing.T) {
+		throttle := newFailureThrottle(1, 50*time.Millisecond)
+		throttle.record("key")
+		if !throttle.blocked("key") {
+			t.Fatal("Expected key to be blocked")
+		}
+		time.Sleep(60 * time.Millisecond)
+		throttle.prune()
+		if throttle.blocked("key") || len(throttle.failures) != 0 {
+			t.Error("Expected expired failures to be dropped")
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package core
+
+import "time"
+
+// Presence statuses - whether a player's device is connected to their seat
+const (
+	PresenceConnected = "connected" // The player's connection is live
+	PresenceAway      = "away"      // The connection dropped - the seat is held for a grace period
+	PresenceGone      = "gone"      // The grace period ran out and the player was removed (only sent in presence_changed)
+)
+
+// MarkAway keeps a disconnected player seated with away presence (thread-safe)
+// Their turn keeps running until the hub's grace period ends it (see Hub.markAway)
+// A simultaneous phase does not wait on away players, so it ends if only away players are still acting
+// Returns the time the player went away in nanoseconds (used to match grace timers to this disconnect)
+// and true if the phase ended, or false if the client is not the connection seated in the room
+func (r *Room) MarkAway(client *Client) (int64, bool, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Clients[client.ClientID] != client {
+		return 0, false, false
+	}
+	member := r.memberLocked(client.ClientID)
+	if member.AwaySince == 0 {
+		member.AwaySince = time.Now().UnixNano()
+	}
+	r.undo = nil // Undoing must not bring back the player's presence from before they went away
+	phaseEnded := r.isWaitingLocked(client.ClientID) && r.finishPhaseIfReadyLocked()
+	return member.AwaySince, phaseEnded, true
+}
+
+// Reconnect gives an away player's seat to their new connection (thread-safe)
+// The player keeps their seat, turn and turn time total
+// Returns false if the client is not away in this room
+func (r *Room) Reconnect(client *Client) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous := r.Clients[client.ClientID]
+	member := r.members[client.ClientID]
+	if previous == nil || member == nil || member.AwaySince == 0 {
+		return false
+	}
+
+	client.TotalTurnTime = previous.TotalTurnTime // Turns may have ended while the player was away
+	r.Clients[client.ClientID] = client
+	member.AwaySince = 0
+	r.undo = nil // Saved totals belong to the old connection
+	return true
+}
+
+// IsAway reports whether a seated player's connection dropped (thread-safe read)
+func (r *Room) IsAway(clientID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.isAwayLocked(clientID)
+}
+
+// HasConnection reports whether client is the connection of a player or spectator in the room (thread-safe read)
+// A stale connection of a client that already reconnected is not
+func (r *Room) HasConnection(client *Client) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.Clients[client.ClientID] == client || r.spectators[client.ClientID] == client
+}
+
+// endAwayTurn ends an away player's turn, and stops a simultaneous phase waiting on them (thread-safe)
+// since must match the time the player went away, so a timer from an earlier disconnect is a no-op
+// Returns true if the turn or phase changed
+func (r *Room) endAwayTurn(clientID string, since int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.isAwaySinceLocked(clientID, since) {
+		return false
+	}
+
+	changed := false
+	if r.CurrentTurn == clientID && r.TurnStartTime != nil {
+		r.clearTurnLocked(turnEnd{by: clientID, reason: TurnEndDisconnect})
+		changed = true
+	}
+	if r.removeFromPhaseLocked(clientID) {
+		changed = true
+	}
+	return changed
+}
+
+// removeAwayClient removes an away player whose seat is no longer held (thread-safe)
+// since must match the time the player went away, so a player who reconnected in the meantime stays
+// Returns false if the player is no longer away from that disconnect; otherwise the results of RemoveClient
+func (r *Room) removeAwayClient(clientID string, since int64) (bool, bool, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.isAwaySinceLocked(clientID, since) {
+		return false, false, false
+	}
+	hadCurrentTurn, isEmpty := r.removeClientLocked(clientID)
+	return hadCurrentTurn, isEmpty, true
+}
+
+// isAwayLocked reports whether a seated player's connection dropped
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) isAwayLocked(clientID string) bool {
+	member := r.members[clientID]
+	return r.Clients[clientID] != nil && member != nil && member.AwaySince != 0
+}
+
+// isAwaySinceLocked reports whether a seated player is still away from the disconnect at since
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) isAwaySinceLocked(clientID string, since int64) bool {
+	return r.isAwayLocked(clientID) && r.members[clientID].AwaySince == since
+}
+
+// presenceLocked returns a seated player's presence (connected or away)
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) presenceLocked(clientID string) string {
+	if r.isAwayLocked(clientID) {
+		return PresenceAway
+	}
+	return PresenceConnected
+}
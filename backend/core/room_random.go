@@ -0,0 +1,75 @@
+package core
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+)
+
+// PickRandomPlayer picks a seated player at random with crypto/rand (thread-safe read)
+// Away players are left out, so a disconnected player is never picked to go first
+// Returns every candidate in seating order and the one picked, or ErrGameEnded once the game is over
+func (r *Room) PickRandomPlayer() ([]string, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return nil, "", err
+	}
+	seats := r.seatOrderLocked()
+	if len(seats) == 0 {
+		return nil, "", errors.New("No players in the room")
+	}
+	candidates := make([]string, 0, len(seats))
+	for _, clientID := range seats {
+		if !r.isAwayLocked(clientID) {
+			candidates = append(candidates, clientID)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, "", errors.New("No connected players in the room")
+	}
+	i, err := randomIndex(len(candidates))
+	if err != nil {
+		return nil, "", errors.New("Could not pick a player")
+	}
+	return candidates, candidates[i], nil
+}
+
+// ShuffleSeatOrder puts the seats in a random order with crypto/rand (thread-safe)
+// changedBy is the client that asked for the shuffle
+// Returns the seating order before and after the shuffle, or an error from checkTurnOrderChangeLocked
+func (r *Room) ShuffleSeatOrder(changedBy string) ([]string, []string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTurnOrderChangeLocked(changedBy); err != nil {
+		return nil, nil, err
+	}
+	before := r.seatOrderLocked()
+	if len(before) == 0 {
+		return nil, nil, errors.New("No players in the room")
+	}
+
+	// Fisher-Yates shuffle
+	order := append([]string(nil), before...)
+	for i := len(order) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return nil, nil, errors.New("Could not shuffle the turn order")
+		}
+		order[i], order[j] = order[j], order[i]
+	}
+
+	r.seats = append(r.seats[:0], order...)
+	return before, append([]string(nil), order...), nil
+}
+
+// randomIndex returns a uniformly random index below n using crypto/rand
+func randomIndex(n int) (int, error) {
+	idx, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(idx.Int64()), nil
+}
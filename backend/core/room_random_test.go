@@ -0,0 +1,101 @@
+package core
+
+import (
+	"sort"
+	"testing"
+)
+
+// setupRandomRoom creates a room with four players
+func setupRandomRoom() *Room {
+	room := NewRoom("TEST123")
+	for _, id := range []string{"client1", "client2", "client3", "client4"} {
+		room.AddClient(createTestClient(id, id, "#FF0000"))
+	}
+	return room
+}
+
+func TestRoomRandom(t *testing.T) {
+	t.Run("PickRandomPlayer", func(t *testing.T) {
+		room := setupRandomRoom()
+		picks := make(map[string]int)
+		for i := 0; i < 200; i++ {
+			candidates, picked, err := room.PickRandomPlayer()
+			if err != nil {
+				t.Fatalf("PickRandomPlayer failed: %v", err)
+			}
+			if len(candidates) != 4 || candidates[0] != "client1" {
+				t.Fatalf("Expected candidates in seating order, got %v", candidates)
+			}
+			picks[picked]++
+		}
+		// 200 picks among 4 players - missing one entirely is vanishingly unlikely
+		if len(picks) != 4 {
+			t.Errorf("Expected every player to be picked at some point, got %v", picks)
+		}
+	})
+
+	t.Run("PickSkipsAwayPlayers", func(t *testing.T) {
+		room := setupRandomRoom()
+		room.MarkAway(room.Clients["client2"])
+		for i := 0; i < 50; i++ {
+			candidates, picked, err := room.PickRandomPlayer()
+			if err != nil {
+				t.Fatalf("PickRandomPlayer failed: %v", err)
+			}
+			if len(candidates) != 3 || picked == "client2" {
+				t.Fatalf("Expected the away player to be left out, got %v (picked %s)", candidates, picked)
+			}
+		}
+
+		for _, id := range []string{"client1", "client3", "client4"} {
+			room.MarkAway(room.Clients[id])
+		}
+		if _, _, err := room.PickRandomPlayer(); err == nil {
+			t.Error("Expected error when every player is away")
+		}
+	})
+
+	t.Run("PickFromEmptyRoom", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		if _, _, err := room.PickRandomPlayer(); err == nil {
+			t.Error("Expected error for an empty room")
+		}
+	})
+
+	t.Run("ShuffleIsPermutation", func(t *testing.T) {
+		room := setupRandomRoom()
+		changed := false
+		for i := 0; i < 20; i++ {
+			before, after, err := room.ShuffleSeatOrder("")
+			if err != nil {
+				t.Fatalf("ShuffleSeatOrder failed: %v", err)
+			}
+			if got := room.GetSeatOrder(); !equalStrings(got, after) {
+				t.Fatalf("Expected the seats to match the shuffle, got %v and %v", got, after)
+			}
+			sorted := append([]string(nil), after...)
+			sort.Strings(sorted)
+			if !equalStrings(sorted, []string{"client1", "client2", "client3", "client4"}) {
+				t.Fatalf("Expected a permutation of the players, got %v", after)
+			}
+			if !equalStrings(before, after) {
+				changed = true
+			}
+		}
+		if !changed {
+			t.Error("Expected at least one of 20 shuffles to change the order")
+		}
+	})
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
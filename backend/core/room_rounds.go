@@ -0,0 +1,104 @@
+package core
+
+// RoundPlayerStats is one player's share of a round
+type RoundPlayerStats struct {
+	ClientID   string `json:"client_id"`
+	Turns      int    `json:"turns"`        // Turns started this round
+	TimeUsedMs int64  `json:"time_used_ms"` // Time spent in turns this round (in milliseconds)
+}
+
+// RoundSummary describes a completed round
+type RoundSummary struct {
+	Round   int                `json:"round"`
+	Players []RoundPlayerStats `json:"players"` // Seated players in seating order, then players who left mid-round
+}
+
+// GetRound returns the current round number, starting at 1 (thread-safe read)
+func (r *Room) GetRound() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.round
+}
+
+// TakeCompletedRounds returns the rounds completed since the last call and forgets them (thread-safe)
+// Rounds complete as a side effect of turn changes, so call this whenever a turn change is announced
+func (r *Room) TakeCompletedRounds() []RoundSummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	completed := r.completedRounds
+	r.completedRounds = nil
+	return completed
+}
+
+// recordTurnStartLocked counts a turn for clientID, completing the round first if play wrapped past the first seat
+// MUST be called with r.mu.Lock() held
+func (r *Room) recordTurnStartLocked(clientID string) {
+	if len(r.roundPlayers) > 0 && !r.anyPassedLocked() {
+		if seats := r.seatOrderLocked(); len(seats) > 0 && seats[0] == clientID {
+			r.completeRoundLocked()
+		}
+	}
+
+	member := r.memberLocked(clientID)
+	if member.RoundTurns == 0 && member.RoundTimeMs == 0 {
+		r.roundPlayers = append(r.roundPlayers, clientID)
+	}
+	member.RoundTurns++
+}
+
+// recordTurnEndLocked adds a finished turn's time to the round, completing the round once every seated player has had a turn
+// MUST be called with r.mu.Lock() held
+func (r *Room) recordTurnEndLocked(clientID string, durationMs int64) {
+	r.memberLocked(clientID).RoundTimeMs += durationMs
+	if r.anyPassedLocked() {
+		return // Once someone passed, the round lasts until everyone has passed
+	}
+
+	seats := r.seatOrderLocked()
+	if len(seats) == 0 {
+		return
+	}
+	for _, seat := range seats {
+		if member := r.members[seat]; member == nil || member.RoundTurns == 0 {
+			return
+		}
+	}
+	r.completeRoundLocked()
+}
+
+// completeRoundLocked queues a summary of the current round and starts the next one
+// MUST be called with r.mu.Lock() held
+func (r *Room) completeRoundLocked() {
+	summary := RoundSummary{Round: r.round}
+	seen := make(map[string]bool, len(r.seats))
+	for _, clientID := range r.seatOrderLocked() {
+		seen[clientID] = true
+		summary.Players = append(summary.Players, r.roundStatsLocked(clientID))
+	}
+	for _, clientID := range r.roundPlayers {
+		if !seen[clientID] {
+			summary.Players = append(summary.Players, r.roundStatsLocked(clientID))
+		}
+	}
+	r.completedRounds = append(r.completedRounds, summary)
+
+	for _, member := range r.members {
+		member.RoundTurns = 0
+		member.RoundTimeMs = 0
+		member.Passed = false
+	}
+	r.roundPlayers = nil
+	r.round++
+}
+
+// roundStatsLocked returns a player's stats for the current round
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) roundStatsLocked(clientID string) RoundPlayerStats {
+	stats := RoundPlayerStats{ClientID: clientID}
+	if member := r.members[clientID]; member != nil {
+		stats.Turns = member.RoundTurns
+		stats.TimeUsedMs = member.RoundTimeMs
+	}
+	return stats
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func setupRoundRoom() *Room {
+	room := NewRoom("TEST123")
+	room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+	room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+	room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+	return room
+}
+
+func TestRoomRounds(t *testing.T) {
+	t.Run("StartsAtRoundOne", func(t *testing.T) {
+		room := setupRoundRoom()
+		if room.GetRound() != 1 {
+			t.Errorf("Expected round 1, got %d", room.GetRound())
+		}
+		if room.Snapshot().Round != 1 {
+			t.Errorf("Expected snapshot round 1, got %d", room.Snapshot().Round)
+		}
+	})
+
+	t.Run("CompletesWhenEveryoneHasPlayed", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.AdvanceTurn("", "")
+		room.AdvanceTurn("client1", "")
+		room.AdvanceTurn("client2", "")
+		if completed := room.TakeCompletedRounds(); len(completed) != 0 {
+			t.Fatalf("Expected no completed round while client3 is playing, got %+v", completed)
+		}
+
+		room.ClearCurrentTurn("")
+		completed := room.TakeCompletedRounds()
+		if len(completed) != 1 {
+			t.Fatalf("Expected 1 completed round, got %d", len(completed))
+		}
+		summary := completed[0]
+		if summary.Round != 1 || len(summary.Players) != 3 {
+			t.Fatalf("Unexpected summary: %+v", summary)
+		}
+		for i, want := range []string{"client1", "client2", "client3"} {
+			if summary.Players[i].ClientID != want || summary.Players[i].Turns != 1 {
+				t.Errorf("Expected %s with 1 turn, got %+v", want, summary.Players[i])
+			}
+		}
+		if room.GetRound() != 2 {
+			t.Errorf("Expected round 2, got %d", room.GetRound())
+		}
+		if again := room.TakeCompletedRounds(); len(again) != 0 {
+			t.Errorf("Expected completed rounds to be drained, got %+v", again)
+		}
+	})
+
+	t.Run("CompletesWhenPlayWrapsToFirstSeat", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.SetCurrentTurn("", "client1", "")
+		room.SetCurrentTurn("client1", "client3", "") // client2 is skipped
+		room.SetCurrentTurn("client3", "client1", "")
+
+		completed := room.TakeCompletedRounds()
+		if len(completed) != 1 {
+			t.Fatalf("Expected 1 completed round, got %d", len(completed))
+		}
+		players := completed[0].Players
+		if players[0].Turns != 1 || players[1].Turns != 0 || players[2].Turns != 1 {
+			t.Errorf("Expected turn counts [1 0 1], got %+v", players)
+		}
+		if room.GetRound() != 2 {
+			t.Errorf("Expected round 2, got %d", room.GetRound())
+		}
+	})
+
+	t.Run("TracksTimeUsed", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 3*time.Second)
+		room.SetCurrentTurn("client1", "client2", "")
+		backdateTurn(room, 2*time.Second)
+		room.ClearCurrentTurn("")
+
+		completed := room.TakeCompletedRounds()
+		if len(completed) != 1 {
+			t.Fatalf("Expected 1 completed round, got %d", len(completed))
+		}
+		players := completed[0].Players
+		if !withinMs(players[0].TimeUsedMs, 3000) || !withinMs(players[1].TimeUsedMs, 2000) {
+			t.Errorf("Expected ~3000ms and ~2000ms, got %+v", players)
+		}
+	})
+
+	t.Run("IncludesPlayersWhoLeft", func(t *testing.T) {
+		room := setupRoundRoom()
+		room.SetCurrentTurn("", "client2", "")
+		room.RemoveClient("client2")
+		room.SetCurrentTurn("", "client3", "")
+		room.SetCurrentTurn("client3", "client1", "")
+
+		completed := room.TakeCompletedRounds()
+		if len(completed) != 1 {
+			t.Fatalf("Expected 1 completed round, got %d", len(completed))
+		}
+		players := completed[0].Players
+		if len(players) != 3 || players[2].ClientID != "client2" || players[2].Turns != 1 {
+			t.Errorf("Expected client2 listed last with 1 turn, got %+v", players)
+		}
+	})
+}
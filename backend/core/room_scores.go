@@ -0,0 +1,173 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// MaxScore caps the absolute value of a player's score
+	MaxScore = 1_000_000_000
+	// MaxScoreReasonLength caps the length of a score change reason
+	MaxScoreReasonLength = 100
+	// MaxScoreLedger caps the score changes kept per room (the oldest are dropped first)
+	MaxScoreLedger = 1000
+)
+
+// ScoreEntry is a score change in the room's score ledger
+type ScoreEntry struct {
+	Seq       int    `json:"seq"` // Position in the room's ledger, starting at 0 (never reused)
+	ClientID  string `json:"client_id"`
+	Delta     int64  `json:"delta"`               // Change applied to the score
+	Score     int64  `json:"score"`               // Score after the change
+	Reason    string `json:"reason,omitempty"`    // Optional note from the player who made the change
+	ChangedBy string `json:"changed_by"`          // clientID that made the change
+	At        int64  `json:"at"`                  // Unix timestamp in milliseconds of the change
+	RevertOf  *int   `json:"revert_of,omitempty"` // Seq of the change this entry reverts (nil for ordinary changes)
+	Reverted  bool   `json:"reverted,omitempty"`  // A later entry reverted this change
+}
+
+// ScoreLedgerPage is a page of the room's score ledger
+type ScoreLedgerPage struct {
+	Entries []ScoreEntry `json:"entries"` // Oldest first
+	Offset  int          `json:"offset"`  // Index of the first returned entry among the kept entries
+	Total   int          `json:"total"`   // Number of entries kept
+}
+
+// ChangeScore sets a seated player's score, or adjusts it if delta is true (thread-safe)
+// changedBy is the client that made the change and reason is an optional note (both go into the ledger)
+// Returns the ledger entry and the room's new score version
+func (r *Room) ChangeScore(clientID string, value int64, delta bool, reason, changedBy string) (ScoreEntry, uint64, error) {
+	if len(reason) > MaxScoreReasonLength {
+		return ScoreEntry{}, 0, errors.New("Reason is too long")
+	}
+	// No valid change is larger than this, and bounding it first keeps the arithmetic from overflowing
+	if value > 2*MaxScore || value < -2*MaxScore {
+		return ScoreEntry{}, 0, errors.New("Score out of range")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return ScoreEntry{}, 0, err
+	}
+
+	if r.Clients[clientID] == nil {
+		return ScoreEntry{}, 0, errors.New("Player not found")
+	}
+
+	member := r.memberLocked(clientID)
+	change := value
+	if !delta {
+		change = value - member.Score
+	}
+	entry, err := r.applyScoreLocked(member, change, reason, changedBy, nil)
+	if err != nil {
+		return ScoreEntry{}, 0, err
+	}
+	return entry, r.scoreVersion, nil
+}
+
+// RevertScore undoes a change in the score ledger by applying the opposite change (thread-safe)
+// The player does not need to be seated, so changes for players who left can be reverted too
+// Returns the new ledger entry and the room's new score version
+func (r *Room) RevertScore(seq int, changedBy string) (ScoreEntry, uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return ScoreEntry{}, 0, err
+	}
+
+	var target *ScoreEntry
+	for i := range r.scoreLedger {
+		if r.scoreLedger[i].Seq == seq {
+			target = &r.scoreLedger[i]
+			break
+		}
+	}
+	if target == nil {
+		return ScoreEntry{}, 0, errors.New("Score change not found")
+	}
+	if target.Reverted || target.RevertOf != nil {
+		return ScoreEntry{}, 0, errors.New("Score change cannot be reverted")
+	}
+
+	member := r.members[target.ClientID]
+	if member == nil {
+		return ScoreEntry{}, 0, errors.New("Player not found")
+	}
+	// Mark before applying - applyScoreLocked may move the ledger, leaving target pointing at the old copy
+	target.Reverted = true
+	revertOf := target.Seq
+	entry, err := r.applyScoreLocked(member, -target.Delta, "", changedBy, &revertOf)
+	if err != nil {
+		target.Reverted = false
+		return ScoreEntry{}, 0, err
+	}
+	return entry, r.scoreVersion, nil
+}
+
+// GetScoreLedger returns a page of the score ledger, oldest first (thread-safe read)
+// Page sizes follow the turn history (see GetHistory)
+func (r *Room) GetScoreLedger(offset, limit int) ScoreLedgerPage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if limit <= 0 {
+		limit = DefaultHistoryPageSize
+	}
+	if limit > MaxHistoryPageSize {
+		limit = MaxHistoryPageSize
+	}
+
+	page := ScoreLedgerPage{Entries: []ScoreEntry{}, Offset: offset, Total: len(r.scoreLedger)}
+	if offset < 0 || offset >= len(r.scoreLedger) {
+		return page
+	}
+	end := offset + limit
+	if end > len(r.scoreLedger) {
+		end = len(r.scoreLedger)
+	}
+	page.Entries = append(page.Entries, r.scoreLedger[offset:end]...)
+	return page
+}
+
+// GetScoreVersion returns the version of the room's scores (thread-safe read)
+// The version goes up with every score change, so clients can drop stale score_changed messages
+func (r *Room) GetScoreVersion() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.scoreVersion
+}
+
+// applyScoreLocked changes a member's score and records the change in the ledger
+// MUST be called with r.mu.Lock() held
+func (r *Room) applyScoreLocked(member *Member, change int64, reason, changedBy string, revertOf *int) (ScoreEntry, error) {
+	score := member.Score + change
+	if score > MaxScore || score < -MaxScore {
+		return ScoreEntry{}, errors.New("Score out of range")
+	}
+	member.Score = score
+
+	entry := ScoreEntry{
+		Seq:       r.scoreSeq,
+		ClientID:  member.ClientID,
+		Delta:     change,
+		Score:     score,
+		Reason:    reason,
+		ChangedBy: changedBy,
+		At:        time.Now().UnixMilli(),
+		RevertOf:  revertOf,
+	}
+	r.scoreSeq++
+	r.scoreVersion++
+
+	if len(r.scoreLedger) >= MaxScoreLedger {
+		// Drop the oldest change, copying so the backing array does not grow forever
+		r.scoreLedger = append(r.scoreLedger[:0:0], r.scoreLedger[1:]...)
+	}
+	r.scoreLedger = append(r.scoreLedger, entry)
+	return entry, nil
+}
@@ -0,0 +1,117 @@
+package core
+
+import "testing"
+
+// setupScoreRoom creates a two-player room
+func setupScoreRoom() *Room {
+	room := NewRoom("TEST123")
+	room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+	room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+	return room
+}
+
+func TestRoomScores(t *testing.T) {
+	t.Run("DeltaAndAbsolute", func(t *testing.T) {
+		room := setupScoreRoom()
+
+		entry, version, err := room.ChangeScore("client1", 5, true, "Bonus", "client2")
+		if err != nil {
+			t.Fatalf("ChangeScore failed: %v", err)
+		}
+		if entry.Score != 5 || entry.Delta != 5 || entry.ChangedBy != "client2" || entry.Reason != "Bonus" || version != 1 {
+			t.Errorf("Unexpected entry %+v (version %d)", entry, version)
+		}
+
+		entry, version, _ = room.ChangeScore("client1", 12, false, "", "client1")
+		if entry.Score != 12 || entry.Delta != 7 || version != 2 {
+			t.Errorf("Expected absolute change recorded as +7, got %+v (version %d)", entry, version)
+		}
+		if peers := room.ListPeerInfo(); peers[0].Score != 12 {
+			t.Errorf("Expected peer score 12, got %d", peers[0].Score)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		room := setupScoreRoom()
+		if _, _, err := room.ChangeScore("ghost", 1, true, "", "client1"); err == nil {
+			t.Error("Expected error for a player not in the room")
+		}
+		if _, _, err := room.ChangeScore("client1", MaxScore+1, false, "", "client1"); err == nil {
+			t.Error("Expected error for a score out of range")
+		}
+		if _, _, err := room.ChangeScore("client1", 1<<62, true, "", "client1"); err == nil {
+			t.Error("Expected error for a huge delta")
+		}
+		long := make([]byte, MaxScoreReasonLength+1)
+		if _, _, err := room.ChangeScore("client1", 1, true, string(long), "client1"); err == nil {
+			t.Error("Expected error for a long reason")
+		}
+		if room.GetScoreVersion() != 0 {
+			t.Errorf("Expected rejected changes to leave the version alone, got %d", room.GetScoreVersion())
+		}
+	})
+
+	t.Run("Revert", func(t *testing.T) {
+		room := setupScoreRoom()
+		mistake, _, _ := room.ChangeScore("client1", 50, true, "Typo", "client2")
+		room.ChangeScore("client1", 3, true, "", "client1")
+
+		entry, version, err := room.RevertScore(mistake.Seq, "client1")
+		if err != nil {
+			t.Fatalf("RevertScore failed: %v", err)
+		}
+		if entry.Delta != -50 || entry.Score != 3 || entry.RevertOf == nil || *entry.RevertOf != mistake.Seq || version != 3 {
+			t.Errorf("Unexpected revert entry %+v (version %d)", entry, version)
+		}
+		if _, _, err := room.RevertScore(mistake.Seq, "client1"); err == nil {
+			t.Error("Expected a second revert to fail")
+		}
+		if _, _, err := room.RevertScore(entry.Seq, "client1"); err == nil {
+			t.Error("Expected reverting a revert to fail")
+		}
+		if _, _, err := room.RevertScore(99, "client1"); err == nil {
+			t.Error("Expected unknown entry to fail")
+		}
+
+		page := room.GetScoreLedger(0, 0)
+		if page.Total != 3 || !page.Entries[0].Reverted {
+			t.Errorf("Expected 3 entries with the first marked reverted, got %+v", page)
+		}
+	})
+
+	t.Run("SurvivesReconnect", func(t *testing.T) {
+		room := setupScoreRoom()
+		room.ChangeScore("client1", 8, true, "", "client1")
+		room.RemoveClient("client1")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		if peers := room.ListPeerInfo(); peers[1].Score != 8 {
+			t.Errorf("Expected score to survive rejoin, got %d", peers[1].Score)
+		}
+	})
+
+	t.Run("UndoKeepsScores", func(t *testing.T) {
+		room := setupScoreRoom()
+		room.SetSettings(RoomSettings{UndoDepth: 3})
+		room.SetCurrentTurn("", "client1", "")
+		room.SetCurrentTurn("client1", "client2", "")
+		room.ChangeScore("client1", 4, true, "", "client1")
+
+		if err := room.UndoTurn("client2", "client1"); err != nil {
+			t.Fatalf("UndoTurn failed: %v", err)
+		}
+		if peers := room.ListPeerInfo(); peers[0].Score != 4 {
+			t.Errorf("Expected undo to leave the score alone, got %d", peers[0].Score)
+		}
+	})
+
+	t.Run("LedgerCapped", func(t *testing.T) {
+		room := setupScoreRoom()
+		for i := 0; i < MaxScoreLedger+5; i++ {
+			room.ChangeScore("client1", 1, true, "", "client1")
+		}
+		page := room.GetScoreLedger(0, 1)
+		if page.Total != MaxScoreLedger || page.Entries[0].Seq != 5 {
+			t.Errorf("Expected the oldest entries dropped, got total=%d first=%d", page.Total, page.Entries[0].Seq)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package core
+
+import "errors"
+
+// ErrInvalidSeatOrder is returned when a new seating order does not list every player exactly once
+var ErrInvalidSeatOrder = errors.New("Turn order must list every player in the room exactly once")
+
+// GetSeatOrder returns the client IDs in seating order (thread-safe read)
+func (r *Room) GetSeatOrder() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.seatOrderLocked()
+}
+
+// SetSeatOrder replaces the seating order (thread-safe)
+// order must contain every client in the room exactly once
+// changedBy is the client that asked for the new order
+// Returns an error from checkTurnOrderChangeLocked if the order may not change,
+// or ErrInvalidSeatOrder if order is not a permutation of the current seats
+func (r *Room) SetSeatOrder(order []string, changedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTurnOrderChangeLocked(changedBy); err != nil {
+		return err
+	}
+	if len(order) != len(r.Clients) {
+		return ErrInvalidSeatOrder
+	}
+
+	seen := make(map[string]bool, len(order))
+	for _, clientID := range order {
+		if r.Clients[clientID] == nil || seen[clientID] {
+			return ErrInvalidSeatOrder
+		}
+		seen[clientID] = true
+	}
+
+	r.seats = append(r.seats[:0], order...)
+	return nil
+}
+
+// AdvanceTurn moves the current turn to the next seat atomically
+// Validates expectedCurrentTurn matches before advancing (optimistic concurrency)
+// If no turn is active, play continues after the seat that last had a turn
+// changedBy is the client that requested the change (recorded in the turn history)
+// Returns the client ID that now has the turn, an error from checkSingleTurnChangeLocked if the turn
+// may not change, or ErrTurnStateMismatch if validation failed or the room is empty
+func (r *Room) AdvanceTurn(expectedCurrentTurn, changedBy string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkSingleTurnChangeLocked(changedBy); err != nil {
+		return "", err
+	}
+	if r.CurrentTurn != expectedCurrentTurn {
+		return "", ErrTurnStateMismatch
+	}
+
+	anchor := r.CurrentTurn
+	if anchor == "" {
+		anchor = r.lastTurn
+	}
+
+	next := r.nextSeatLocked(anchor)
+	if next == "" {
+		return "", ErrTurnStateMismatch // No one seated
+	}
+
+	r.startTurnLocked(next, turnEnd{by: changedBy, reason: TurnEndManual})
+	return next, nil
+}
+
+// seatOrderLocked returns a copy of the seating order restricted to clients in the room
+// Clients added to the map without a seat are appended so they are never hidden
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) seatOrderLocked() []string {
+	order := make([]string, 0, len(r.Clients))
+	seated := make(map[string]bool, len(r.seats))
+	for _, clientID := range r.seats {
+		if r.Clients[clientID] != nil && !seated[clientID] {
+			order = append(order, clientID)
+			seated[clientID] = true
+		}
+	}
+	for clientID := range r.Clients {
+		if !seated[clientID] {
+			order = append(order, clientID)
+		}
+	}
+	return order
+}
+
+// nextSeatLocked returns the client ID seated after the given client, skipping players who passed or are away
+// Returns the first seat if clientID is not seated, or "" if the room is empty
+// If every other player passed or is away, the seat directly after clientID is returned
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) nextSeatLocked(clientID string) string {
+	seats := r.seatOrderLocked()
+	if len(seats) == 0 {
+		return ""
+	}
+
+	start := 0
+	for i, seat := range seats {
+		if seat == clientID {
+			start = i + 1
+			break
+		}
+	}
+	for offset := 0; offset < len(seats); offset++ {
+		seat := seats[(start+offset)%len(seats)]
+		if member := r.members[seat]; member == nil || (!member.Passed && member.AwaySince == 0) {
+			return seat
+		}
+	}
+	return seats[start%len(seats)]
+}
+
+// removeSeatLocked removes a client's seat
+// If play was anchored on that seat, the anchor moves to the previous seat so
+// next_turn still continues with the player who sat after the removed one
+// MUST be called with r.mu.Lock() held
+func (r *Room) removeSeatLocked(clientID string) {
+	for i, seat := range r.seats {
+		if seat != clientID {
+			continue
+		}
+		if r.lastTurn == clientID {
+			r.lastTurn = ""
+			if i > 0 {
+				r.lastTurn = r.seats[i-1]
+			} else if len(r.seats) > 1 {
+				r.lastTurn = r.seats[len(r.seats)-1]
+			}
+		}
+		r.seats = append(r.seats[:i], r.seats[i+1:]...)
+		return
+	}
+}
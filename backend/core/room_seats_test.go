@@ -0,0 +1,161 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestRoomSeats(t *testing.T) {
+	t.Run("JoinOrder", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+
+		order := room.GetSeatOrder()
+		expected := []string{"client1", "client2", "client3"}
+		if len(order) != len(expected) {
+			t.Fatalf("Expected %d seats, got %d", len(expected), len(order))
+		}
+		for i := range expected {
+			if order[i] != expected[i] {
+				t.Errorf("Expected seat %d to be %s, got %s", i, expected[i], order[i])
+			}
+		}
+
+		peers := room.ListPeerInfo()
+		for i := range expected {
+			if peers[i].ClientID != expected[i] {
+				t.Errorf("Expected peer %d to be %s, got %s", i, expected[i], peers[i].ClientID)
+			}
+		}
+	})
+
+	t.Run("SetSeatOrder", func(t *testing.T) {
+		t.Run("ValidPermutation", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+			room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+			if room.SetSeatOrder([]string{"client2", "client1"}, "") != nil {
+				t.Fatal("Expected SetSeatOrder to succeed")
+			}
+
+			order := room.GetSeatOrder()
+			if order[0] != "client2" || order[1] != "client1" {
+				t.Errorf("Expected [client2 client1], got %v", order)
+			}
+		})
+
+		t.Run("RejectsInvalidOrders", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+			room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+			invalid := [][]string{
+				{"client1"},                       // Missing a player
+				{"client1", "client1"},            // Duplicate
+				{"client1", "unknown"},            // Not in room
+				{"client1", "client2", "client3"}, // Too many
+			}
+			for _, order := range invalid {
+				if room.SetSeatOrder(order, "") == nil {
+					t.Errorf("Expected SetSeatOrder(%v) to fail", order)
+				}
+			}
+
+			order := room.GetSeatOrder()
+			if order[0] != "client1" || order[1] != "client2" {
+				t.Errorf("Expected order to be unchanged, got %v", order)
+			}
+		})
+	})
+
+	t.Run("AdvanceTurn", func(t *testing.T) {
+		t.Run("StartsAtFirstSeat", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+			room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+
+			next, err := room.AdvanceTurn("", "")
+			if err != nil || next != "client1" {
+				t.Errorf("Expected client1 to start, got %q (%v)", next, err)
+			}
+			if room.CurrentTurn != "client1" || room.TurnStartTime == nil {
+				t.Error("Expected turn to be active for client1")
+			}
+		})
+
+		t.Run("WrapsAround", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+			room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+			room.SetCurrentTurn("", "client1", "")
+
+			if next, _ := room.AdvanceTurn("client1", ""); next != "client2" {
+				t.Errorf("Expected client2, got %s", next)
+			}
+			if next, _ := room.AdvanceTurn("client2", ""); next != "client1" {
+				t.Errorf("Expected wrap to client1, got %s", next)
+			}
+		})
+
+		t.Run("StateMismatch", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+			room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+			room.SetCurrentTurn("", "client1", "")
+
+			if _, err := room.AdvanceTurn("client2", ""); err == nil {
+				t.Error("Expected AdvanceTurn to fail on state mismatch")
+			}
+			if room.CurrentTurn != "client1" {
+				t.Errorf("Expected turn to stay with client1, got %s", room.CurrentTurn)
+			}
+		})
+
+		t.Run("EmptyRoom", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			if _, err := room.AdvanceTurn("", ""); err == nil {
+				t.Error("Expected AdvanceTurn to fail in empty room")
+			}
+		})
+
+		t.Run("ContinuesAfterClearedTurn", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+			room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+			room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+			room.SetCurrentTurn("", "client2", "")
+			room.ClearCurrentTurn("")
+
+			if next, _ := room.AdvanceTurn("", ""); next != "client3" {
+				t.Errorf("Expected client3 after cleared turn, got %s", next)
+			}
+		})
+
+		t.Run("ContinuesAfterCurrentPlayerLeaves", func(t *testing.T) {
+			room := NewRoom("TEST123")
+			room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+			room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+			room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+			room.SetCurrentTurn("", "client2", "")
+			room.RemoveClient("client2")
+
+			if next, _ := room.AdvanceTurn("", ""); next != "client3" {
+				t.Errorf("Expected client3 after client2 left, got %s", next)
+			}
+		})
+	})
+
+	t.Run("RemoveClientFreesSeat", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+		room.RemoveClient("client1")
+
+		order := room.GetSeatOrder()
+		if len(order) != 1 || order[0] != "client2" {
+			t.Errorf("Expected [client2], got %v", order)
+		}
+	})
+}
@@ -0,0 +1,264 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// MaxTurnTimeLimit caps the configurable turn length
+	MaxTurnTimeLimit = 24 * time.Hour
+	// MaxClockBank caps the configurable chess clock time bank
+	MaxClockBank = 24 * time.Hour
+	// MaxClockIncrement caps the configurable chess clock increment or delay
+	MaxClockIncrement = time.Hour
+	// DefaultKickBan is how long a kicked player stays out when the room does not configure it
+	DefaultKickBan = 10 * time.Minute
+	// MaxKickBan caps the configurable kick ban
+	MaxKickBan = 24 * time.Hour
+)
+
+// Turn expiry policies - what happens when a turn reaches its time limit
+const (
+	TurnExpiryFlag    = "flag"    // Only notify players, the turn keeps running
+	TurnExpiryEnd     = "end"     // End the turn, nobody has the turn afterwards
+	TurnExpiryAdvance = "advance" // Advance to the next seat
+)
+
+// Turn modes - how players take turns
+const (
+	TurnModeSequential   = "sequential"   // One player at a time (CurrentTurn)
+	TurnModeSimultaneous = "simultaneous" // Everyone acts at once in phases ended by mark_ready
+)
+
+// Turn control policies - who may change the turn
+const (
+	TurnControlAnyone              = "anyone"                 // Every player may start or end anyone's turn
+	TurnControlCurrentPlayer       = "current_player"         // Only the player whose turn it is may hand it off
+	TurnControlHost                = "host"                   // Only the host may change the turn
+	TurnControlCurrentPlayerOrHost = "current_player_or_host" // The current player may hand off and the host may do anything
+)
+
+// Chess clock modes - how ClockIncrementMs is applied to a player's time bank
+const (
+	ClockFischer   = "fischer"   // Add the increment after every turn
+	ClockBronstein = "bronstein" // Give back the time used, up to the increment
+	ClockDelay     = "delay"     // The first increment of every turn is free
+)
+
+// RoomSettings holds the per-room game configuration
+// Zero values mean the feature is disabled
+type RoomSettings struct {
+	TurnTimeLimitMs  int64  `json:"turn_time_limit_ms,omitempty"` // Maximum turn length in milliseconds (0 = no limit)
+	TurnWarningMs    int64  `json:"turn_warning_ms,omitempty"`    // Send turn_warning when this much time is left (0 = no warning)
+	TurnExpiryPolicy string `json:"turn_expiry_policy,omitempty"` // flag, end or advance (defaults to flag)
+	ClockBankMs      int64  `json:"clock_bank_ms,omitempty"`      // Starting chess clock time bank per player in milliseconds (0 = no clock)
+	ClockIncrementMs int64  `json:"clock_increment_ms,omitempty"` // Increment or delay per turn in milliseconds
+	ClockMode        string `json:"clock_mode,omitempty"`         // fischer, bronstein or delay (defaults to fischer)
+	PauseHostOnly    bool   `json:"pause_host_only,omitempty"`    // Only the host may pause and resume the game
+	TurnMode         string `json:"turn_mode,omitempty"`          // sequential or simultaneous (defaults to sequential)
+	UndoDepth        int    `json:"undo_depth,omitempty"`         // How many turn changes undo_turn can revert (0 = undo disabled)
+	UndoWindowMs     int64  `json:"undo_window_ms,omitempty"`     // How long a turn change stays undoable in milliseconds (0 = no limit)
+	KickBanMs        int64  `json:"kick_ban_ms,omitempty"`        // How long a kicked player cannot rejoin in milliseconds (defaults to DefaultKickBan)
+	TurnControl      string `json:"turn_control,omitempty"`       // anyone, current_player, host or current_player_or_host (defaults to anyone)
+	Capacity         int    `json:"capacity,omitempty"`           // Maximum number of seated players, the rest wait on a waitlist (0 = no limit)
+}
+
+// RoomSettingsPatch holds the settings a client wants to change
+// Fields left nil keep their current value; see RoomSettings for what each field means
+type RoomSettingsPatch struct {
+	TurnTimeLimitMs  *int64  `json:"turn_time_limit_ms"`
+	TurnWarningMs    *int64  `json:"turn_warning_ms"`
+	TurnExpiryPolicy *string `json:"turn_expiry_policy"`
+	ClockBankMs      *int64  `json:"clock_bank_ms"`
+	ClockIncrementMs *int64  `json:"clock_increment_ms"`
+	ClockMode        *string `json:"clock_mode"`
+	PauseHostOnly    *bool   `json:"pause_host_only"`
+	TurnMode         *string `json:"turn_mode"`
+	UndoDepth        *int    `json:"undo_depth"`
+	UndoWindowMs     *int64  `json:"undo_window_ms"`
+	KickBanMs        *int64  `json:"kick_ban_ms"`
+	TurnControl      *string `json:"turn_control"`
+	Capacity         *int    `json:"capacity"`
+}
+
+// Apply returns the settings with the patch's fields changed
+func (p RoomSettingsPatch) Apply(s RoomSettings) RoomSettings {
+	if p.TurnTimeLimitMs != nil {
+		s.TurnTimeLimitMs = *p.TurnTimeLimitMs
+	}
+	if p.TurnWarningMs != nil {
+		s.TurnWarningMs = *p.TurnWarningMs
+	}
+	if p.TurnExpiryPolicy != nil {
+		s.TurnExpiryPolicy = *p.TurnExpiryPolicy
+	}
+	if p.ClockBankMs != nil {
+		s.ClockBankMs = *p.ClockBankMs
+	}
+	if p.ClockIncrementMs != nil {
+		s.ClockIncrementMs = *p.ClockIncrementMs
+	}
+	if p.ClockMode != nil {
+		s.ClockMode = *p.ClockMode
+	}
+	if p.PauseHostOnly != nil {
+		s.PauseHostOnly = *p.PauseHostOnly
+	}
+	if p.TurnMode != nil {
+		s.TurnMode = *p.TurnMode
+	}
+	if p.UndoDepth != nil {
+		s.UndoDepth = *p.UndoDepth
+	}
+	if p.UndoWindowMs != nil {
+		s.UndoWindowMs = *p.UndoWindowMs
+	}
+	if p.KickBanMs != nil {
+		s.KickBanMs = *p.KickBanMs
+	}
+	if p.TurnControl != nil {
+		s.TurnControl = *p.TurnControl
+	}
+	if p.Capacity != nil {
+		s.Capacity = *p.Capacity
+	}
+	return s
+}
+
+// ClockEnabled reports whether the chess clock is active
+func (s RoomSettings) ClockEnabled() bool {
+	return s.ClockBankMs > 0
+}
+
+// Normalize fills in defaults for unset fields
+func (s *RoomSettings) Normalize() {
+	if s.TurnExpiryPolicy == "" {
+		s.TurnExpiryPolicy = TurnExpiryFlag
+	}
+	if s.TurnMode == "" {
+		s.TurnMode = TurnModeSequential
+	}
+	if s.KickBanMs == 0 {
+		s.KickBanMs = DefaultKickBan.Milliseconds()
+	}
+	if s.TurnControl == "" {
+		s.TurnControl = TurnControlAnyone
+	}
+	if s.ClockEnabled() && s.ClockMode == "" {
+		s.ClockMode = ClockFischer
+	}
+}
+
+// Validate checks that the settings are within allowed bounds
+func (s RoomSettings) Validate() error {
+	if s.TurnTimeLimitMs < 0 || s.TurnTimeLimitMs > MaxTurnTimeLimit.Milliseconds() {
+		return errors.New("Invalid turn time limit")
+	}
+	if s.TurnWarningMs < 0 || (s.TurnWarningMs > 0 && s.TurnWarningMs >= s.TurnTimeLimitMs) {
+		return errors.New("Turn warning must be shorter than the turn time limit")
+	}
+	switch s.TurnExpiryPolicy {
+	case "", TurnExpiryFlag, TurnExpiryEnd, TurnExpiryAdvance:
+	default:
+		return errors.New("Invalid turn expiry policy")
+	}
+	if s.ClockBankMs < 0 || s.ClockBankMs > MaxClockBank.Milliseconds() {
+		return errors.New("Invalid clock time bank")
+	}
+	if s.ClockIncrementMs < 0 || s.ClockIncrementMs > MaxClockIncrement.Milliseconds() {
+		return errors.New("Invalid clock increment")
+	}
+	switch s.ClockMode {
+	case "", ClockFischer, ClockBronstein, ClockDelay:
+	default:
+		return errors.New("Invalid clock mode")
+	}
+	switch s.TurnMode {
+	case "", TurnModeSequential, TurnModeSimultaneous:
+	default:
+		return errors.New("Invalid turn mode")
+	}
+	if s.UndoDepth < 0 || s.UndoDepth > MaxUndoDepth {
+		return errors.New("Invalid undo depth")
+	}
+	if s.UndoWindowMs < 0 || s.UndoWindowMs > MaxUndoWindow.Milliseconds() {
+		return errors.New("Invalid undo window")
+	}
+	if s.KickBanMs < 0 || s.KickBanMs > MaxKickBan.Milliseconds() {
+		return errors.New("Invalid kick ban")
+	}
+	switch s.TurnControl {
+	case "", TurnControlAnyone, TurnControlCurrentPlayer, TurnControlHost, TurnControlCurrentPlayerOrHost:
+	default:
+		return errors.New("Invalid turn control")
+	}
+	if s.Capacity != 0 && (s.Capacity < MinRoomCapacity || s.Capacity > MaxRoomCapacity) {
+		return errors.New("Invalid capacity")
+	}
+	return nil
+}
+
+// GetSettings returns a copy of the room settings (thread-safe read)
+func (r *Room) GetSettings() RoomSettings {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.settings
+}
+
+// SetSettings replaces the room settings (thread-safe)
+// Returns an error if the settings are invalid
+func (r *Room) SetSettings(settings RoomSettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.setSettingsLocked(settings)
+}
+
+// UpdateSettings changes only the settings set in the patch (thread-safe)
+// Returns the resulting settings, or an error if they are invalid
+func (r *Room) UpdateSettings(patch RoomSettingsPatch) (RoomSettings, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return RoomSettings{}, err
+	}
+
+	if err := r.setSettingsLocked(patch.Apply(r.settings)); err != nil {
+		return RoomSettings{}, err
+	}
+	return r.settings, nil
+}
+
+// setSettingsLocked validates and applies new room settings (see SetSettings)
+// MUST be called with r.mu.Lock() held
+func (r *Room) setSettingsLocked(settings RoomSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	settings.Normalize()
+
+	if settings.Capacity != r.settings.Capacity && len(r.Clients) > 0 {
+		return errors.New("Capacity can only be set when creating the room")
+	}
+	if settings.TurnMode != r.settings.TurnMode {
+		if r.phase != nil {
+			return errors.New("Cannot change turn mode during a phase")
+		}
+		if r.CurrentTurn != "" {
+			return errors.New("Cannot change turn mode while a turn is active")
+		}
+	}
+
+	clockChanged := settings.ClockBankMs != r.settings.ClockBankMs ||
+		settings.ClockIncrementMs != r.settings.ClockIncrementMs ||
+		settings.ClockMode != r.settings.ClockMode
+	r.settings = settings
+	r.undo = nil // Turn changes made under the old settings cannot be undone
+
+	// A different clock configuration starts everyone over with a full bank
+	if clockChanged {
+		r.resetClocksLocked()
+	}
+	return nil
+}
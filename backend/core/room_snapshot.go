@@ -0,0 +1,55 @@
+package core
+
+// RoomSnapshot is the full room state sent to a client when it creates or joins a room
+type RoomSnapshot struct {
+	RoomID       string       `json:"room_id"`
+	Peers        []PeerInfo   `json:"peers"`
+	Spectators   int          `json:"spectators"`             // Number of spectators watching (they are not listed in peers)
+	Host         string       `json:"host"`                   // Client ID of the host
+	Locked       bool         `json:"locked"`                 // Room only lets back in players who were seated before
+	PINRequired  bool         `json:"pin_required"`           // Newcomers must enter the room PIN to join
+	CurrentTurn  *PeerInfo    `json:"current_turn,omitempty"` // nil if no turn active
+	Settings     RoomSettings `json:"settings"`
+	Round        int          `json:"round"`
+	Clocks       []ClockState `json:"clocks,omitempty"`    // nil if no chess clock
+	PausedAt     *int64       `json:"paused_at,omitempty"` // Unix timestamp in milliseconds when the game was paused (nil if running)
+	Phase        *PhaseState  `json:"phase,omitempty"`     // nil if no simultaneous phase is running
+	Summary      *GameSummary `json:"summary,omitempty"`   // End-of-game summary (nil while the game is running)
+	Teams        []TeamInfo   `json:"teams,omitempty"`     // Teams in creation order (nil if the room has none)
+	ScoreVersion uint64       `json:"score_version"`       // Version of the scores in peers (see score_changed)
+	Counters     []CounterDef `json:"counters,omitempty"`  // Counter definitions (values are in peers; nil if the room has none)
+}
+
+// Snapshot returns the current room state
+// Reads peers, turn and settings separately, so concurrent changes may interleave
+// (clients reconcile through the turn_changed sequence number)
+func (r *Room) Snapshot() RoomSnapshot {
+	snapshot := RoomSnapshot{
+		RoomID:       r.ID,
+		Peers:        r.ListPeerInfo(),
+		Spectators:   r.SpectatorCount(),
+		Host:         r.GetHost(),
+		Locked:       r.IsLocked(),
+		PINRequired:  r.HasPIN(),
+		Settings:     r.GetSettings(),
+		Round:        r.GetRound(),
+		Clocks:       r.ListClocks(),
+		Teams:        r.ListTeams(),
+		ScoreVersion: r.GetScoreVersion(),
+		Counters:     r.ListCounters(),
+	}
+
+	snapshot.Phase = r.GetPhase()
+	snapshot.Summary = r.GetSummary()
+
+	if pausedAt := r.GetPausedAt(); pausedAt != 0 {
+		snapshot.PausedAt = &pausedAt
+	}
+
+	// If current turn is not empty (has a ClientID), use it; otherwise leave nil for null in JSON
+	if currentTurn := r.GetCurrentTurnInfo(); currentTurn.ClientID != "" {
+		snapshot.CurrentTurn = &currentTurn
+	}
+
+	return snapshot
+}
@@ -0,0 +1,30 @@
+package core
+
+// AddSpectator adds a client to the room as a read-only spectator (thread-safe)
+// Spectators receive every broadcast but have no seat and can never take a turn
+// Returns true if the spectator was added, false if the client is already in the room
+func (r *Room) AddSpectator(client *Client) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Clients[client.ClientID] != nil || r.spectators[client.ClientID] != nil {
+		return false // Already in room
+	}
+
+	r.spectators[client.ClientID] = client
+	return true
+}
+
+// IsSpectator reports whether the client is watching the room as a spectator (thread-safe read)
+func (r *Room) IsSpectator(clientID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.spectators[clientID] != nil
+}
+
+// SpectatorCount returns the number of spectators in the room (thread-safe read)
+func (r *Room) SpectatorCount() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.spectators)
+}
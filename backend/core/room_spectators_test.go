@@ -0,0 +1,74 @@
+package core
+
+import "testing"
+
+func TestRoomSpectators(t *testing.T) {
+	t.Run("NotSeated", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		if !room.AddSpectator(createTestClient("watcher", "TV", "#000000")) {
+			t.Fatal("Expected spectator to be added")
+		}
+
+		if peers := room.ListPeerInfo(); len(peers) != 1 || peers[0].ClientID != "client1" {
+			t.Errorf("Expected only client1 in peers, got %+v", peers)
+		}
+		if !room.IsSpectator("watcher") || room.IsSpectator("client1") {
+			t.Error("Expected only watcher to be a spectator")
+		}
+		if snapshot := room.Snapshot(); snapshot.Spectators != 1 || len(snapshot.Peers) != 1 {
+			t.Errorf("Expected 1 spectator reported separately, got %d spectators and %d peers", snapshot.Spectators, len(snapshot.Peers))
+		}
+	})
+
+	t.Run("NeverTakesTurn", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddSpectator(createTestClient("watcher", "TV", "#000000"))
+
+		if room.SetCurrentTurn("", "watcher", "client1") == nil {
+			t.Error("Expected a spectator turn to be rejected")
+		}
+		room.AdvanceTurn("", "client1")
+		if next, _ := room.AdvanceTurn("client1", "client1"); next != "client1" {
+			t.Errorf("Expected next_turn to skip the spectator, got %s", next)
+		}
+	})
+
+	t.Run("OneRolePerClient", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		player := createTestClient("client1", "Alice", "#FF0000")
+		watcher := createTestClient("watcher", "TV", "#000000")
+		room.AddClient(player)
+		room.AddSpectator(watcher)
+
+		if room.AddSpectator(player) || room.AddClient(watcher) {
+			t.Error("Expected a client to keep its existing role")
+		}
+	})
+
+	t.Run("RemoveSpectator", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddSpectator(createTestClient("watcher", "TV", "#000000"))
+		room.SetCurrentTurn("", "client1", "client1")
+
+		hadTurn, isEmpty := room.RemoveClient("watcher")
+		if hadTurn || isEmpty || room.SpectatorCount() != 0 || room.GetCurrentTurn() != "client1" {
+			t.Errorf("Expected spectator removal to leave play alone, got hadTurn=%v isEmpty=%v", hadTurn, isEmpty)
+		}
+	})
+
+	t.Run("RoomWithOnlySpectatorsIsNotEmpty", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+		room.AddSpectator(createTestClient("watcher", "TV", "#000000"))
+
+		if _, isEmpty := room.RemoveClient("client1"); isEmpty {
+			t.Error("Expected the room to stay occupied by its spectator")
+		}
+		if _, isEmpty := room.RemoveClient("watcher"); !isEmpty {
+			t.Error("Expected the room to be empty once the spectator left")
+		}
+	})
+}
@@ -0,0 +1,247 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxTeams caps the number of teams in a room
+const MaxTeams = 8
+
+// team is a group of players that share turns and a turn time total
+type team struct {
+	id              string
+	name            string
+	color           string
+	totalTurnTimeMs int64  // Time the team's members spent in turns (in milliseconds)
+	lastTurn        string // clientID of the member that most recently had the turn
+}
+
+// TeamInfo is a team as sent to clients
+type TeamInfo struct {
+	TeamID        string   `json:"team_id"`
+	Name          string   `json:"name"`
+	Color         string   `json:"color"`
+	Members       []string `json:"members"`         // Client IDs of the seated members in seating order
+	TotalTurnTime int64    `json:"total_turn_time"` // Time the team's members spent in turns (in milliseconds)
+}
+
+// CreateTeam adds a new team to the room (thread-safe)
+// Returns an error if the room already has MaxTeams teams
+func (r *Room) CreateTeam(name, color string) (TeamInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return TeamInfo{}, err
+	}
+
+	if len(r.teams) >= MaxTeams {
+		return TeamInfo{}, errors.New("Too many teams")
+	}
+
+	r.teamSeq++
+	t := &team{
+		id:    fmt.Sprintf("team%d", r.teamSeq),
+		name:  name,
+		color: color,
+	}
+	r.teams = append(r.teams, t)
+	return r.teamInfoLocked(t), nil
+}
+
+// RemoveTeam deletes a team (thread-safe)
+// Its members stay in the room without a team
+func (r *Room) RemoveTeam(teamID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return err
+	}
+
+	for i, t := range r.teams {
+		if t.id != teamID {
+			continue
+		}
+		r.teams = append(r.teams[:i], r.teams[i+1:]...)
+		for _, member := range r.members {
+			if member.TeamID == teamID {
+				member.TeamID = ""
+			}
+		}
+		r.undo = nil // Undoing would put members back on a team that no longer exists
+		return nil
+	}
+	return errors.New("Team not found")
+}
+
+// AssignTeam puts a seated player on a team, or takes them off their team if teamID is empty (thread-safe)
+// Returns an error if the player or team does not exist
+func (r *Room) AssignTeam(clientID, teamID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNotEndedLocked(); err != nil {
+		return err
+	}
+
+	if r.Clients[clientID] == nil {
+		return errors.New("Player not found")
+	}
+	if teamID != "" && r.teamLocked(teamID) == nil {
+		return errors.New("Team not found")
+	}
+	r.memberLocked(clientID).TeamID = teamID
+	r.undo = nil // Undoing would move the player back to their old team
+	return nil
+}
+
+// ListTeams returns the room's teams in creation order (thread-safe read)
+// Returns nil if the room has no teams
+func (r *Room) ListTeams() []TeamInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.teams) == 0 {
+		return nil
+	}
+	teams := make([]TeamInfo, 0, len(r.teams))
+	for _, t := range r.teams {
+		teams = append(teams, r.teamInfoLocked(t))
+	}
+	return teams
+}
+
+// GetTeam returns a team (thread-safe read)
+// Returns false if the team does not exist
+func (r *Room) GetTeam(teamID string) (TeamInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t := r.teamLocked(teamID)
+	if t == nil {
+		return TeamInfo{}, false
+	}
+	return r.teamInfoLocked(t), true
+}
+
+// GetCurrentTeam returns the team of the player whose turn it is (thread-safe read)
+// Returns false if no turn is active or the current player is not on a team
+func (r *Room) GetCurrentTeam() (TeamInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t := r.teamOfLocked(r.CurrentTurn)
+	if t == nil {
+		return TeamInfo{}, false
+	}
+	return r.teamInfoLocked(t), true
+}
+
+// StartTeamTurn gives the turn to a team atomically
+// The turn goes to the team's member seated after the member that had the team's last turn,
+// and every member of the team shares it (see CheckTurnControl)
+// Validates expectedCurrentTurn matches before starting (optimistic concurrency)
+// changedBy is the client that requested the change (recorded in the turn history)
+// Returns the client ID that now has the turn, an error from checkSingleTurnChangeLocked if the turn
+// may not change, ErrTurnStateMismatch if validation failed, or an error if the team has no seated members
+func (r *Room) StartTeamTurn(expectedCurrentTurn, teamID, changedBy string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkSingleTurnChangeLocked(changedBy); err != nil {
+		return "", err
+	}
+	if r.CurrentTurn != expectedCurrentTurn {
+		return "", ErrTurnStateMismatch
+	}
+
+	t := r.teamLocked(teamID)
+	if t == nil {
+		return "", errors.New("Team not found")
+	}
+	members := r.teamMembersLocked(teamID)
+	if len(members) == 0 {
+		return "", errors.New("Team has no players")
+	}
+
+	next := members[0]
+	for i, clientID := range members {
+		if clientID == t.lastTurn {
+			next = members[(i+1)%len(members)]
+			break
+		}
+	}
+
+	r.startTurnLocked(next, turnEnd{by: changedBy, reason: TurnEndManual})
+	return next, nil
+}
+
+// sameTeamLocked reports whether two players are on the same team
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) sameTeamLocked(clientID, otherClientID string) bool {
+	t := r.teamOfLocked(clientID)
+	return t != nil && t == r.teamOfLocked(otherClientID)
+}
+
+// noteTeamTurnLocked remembers that a team member has the turn, so team turns rotate through the team
+// MUST be called with r.mu.Lock() held
+func (r *Room) noteTeamTurnLocked(clientID string) {
+	if t := r.teamOfLocked(clientID); t != nil {
+		t.lastTurn = clientID
+	}
+}
+
+// chargeTeamLocked adds a finished turn to the player's team total
+// MUST be called with r.mu.Lock() held
+func (r *Room) chargeTeamLocked(clientID string, durationMs int64) {
+	if t := r.teamOfLocked(clientID); t != nil {
+		t.totalTurnTimeMs += durationMs
+	}
+}
+
+// teamOfLocked returns the team a player is on, or nil
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) teamOfLocked(clientID string) *team {
+	member := r.members[clientID]
+	if member == nil || member.TeamID == "" {
+		return nil
+	}
+	return r.teamLocked(member.TeamID)
+}
+
+// teamLocked returns the team with the given ID, or nil
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) teamLocked(teamID string) *team {
+	for _, t := range r.teams {
+		if t.id == teamID {
+			return t
+		}
+	}
+	return nil
+}
+
+// teamMembersLocked returns the seated members of a team in seating order
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) teamMembersLocked(teamID string) []string {
+	members := make([]string, 0)
+	for _, clientID := range r.seatOrderLocked() {
+		if member := r.members[clientID]; member != nil && member.TeamID == teamID {
+			members = append(members, clientID)
+		}
+	}
+	return members
+}
+
+// teamInfoLocked returns the client view of a team
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) teamInfoLocked(t *team) TeamInfo {
+	return TeamInfo{
+		TeamID:        t.id,
+		Name:          t.name,
+		Color:         t.color,
+		Members:       r.teamMembersLocked(t.id),
+		TotalTurnTime: t.totalTurnTimeMs,
+	}
+}
@@ -0,0 +1,139 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// setupTeamRoom creates a four-player room with two teams: client1 and client3 on team1, client2 and client4 on team2
+func setupTeamRoom(t *testing.T) *Room {
+	t.Helper()
+	room := NewRoom("TEST123")
+	for _, id := range []string{"client1", "client2", "client3", "client4"} {
+		room.AddClient(createTestClient(id, id, "#FF0000"))
+	}
+	for _, name := range []string{"North", "East"} {
+		if _, err := room.CreateTeam(name, "#00FF00"); err != nil {
+			t.Fatalf("CreateTeam failed: %v", err)
+		}
+	}
+	for clientID, teamID := range map[string]string{"client1": "team1", "client3": "team1", "client2": "team2", "client4": "team2"} {
+		if err := room.AssignTeam(clientID, teamID); err != nil {
+			t.Fatalf("AssignTeam failed: %v", err)
+		}
+	}
+	return room
+}
+
+func TestRoomTeams(t *testing.T) {
+	t.Run("ListTeams", func(t *testing.T) {
+		room := setupTeamRoom(t)
+		teams := room.ListTeams()
+		if len(teams) != 2 || teams[0].TeamID != "team1" || teams[1].Name != "East" {
+			t.Fatalf("Expected two teams in creation order, got %+v", teams)
+		}
+		if members := teams[0].Members; len(members) != 2 || members[0] != "client1" || members[1] != "client3" {
+			t.Errorf("Expected team1 members in seat order, got %v", members)
+		}
+		if peers := room.ListPeerInfo(); peers[1].TeamID != "team2" {
+			t.Errorf("Expected peer info to carry the team, got %+v", peers[1])
+		}
+	})
+
+	t.Run("AssignErrors", func(t *testing.T) {
+		room := setupTeamRoom(t)
+		if err := room.AssignTeam("ghost", "team1"); err == nil {
+			t.Error("Expected error for unknown player")
+		}
+		if err := room.AssignTeam("client1", "team9"); err == nil {
+			t.Error("Expected error for unknown team")
+		}
+		if err := room.AssignTeam("client1", ""); err != nil {
+			t.Errorf("Expected unassign to succeed, got %v", err)
+		}
+	})
+
+	t.Run("TooManyTeams", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		for i := 0; i < MaxTeams; i++ {
+			room.CreateTeam("Team", "#FF0000")
+		}
+		if _, err := room.CreateTeam("Team", "#FF0000"); err == nil {
+			t.Error("Expected error past MaxTeams")
+		}
+	})
+
+	t.Run("RemoveTeamClearsMembers", func(t *testing.T) {
+		room := setupTeamRoom(t)
+		if err := room.RemoveTeam("team1"); err != nil {
+			t.Fatalf("RemoveTeam failed: %v", err)
+		}
+		if peers := room.ListPeerInfo(); peers[0].TeamID != "" {
+			t.Errorf("Expected client1 to have no team, got %s", peers[0].TeamID)
+		}
+		if err := room.RemoveTeam("team1"); err == nil {
+			t.Error("Expected error removing a missing team")
+		}
+	})
+
+	t.Run("TeamTurnsRotate", func(t *testing.T) {
+		room := setupTeamRoom(t)
+		current := ""
+		for _, want := range []string{"client1", "client3", "client1"} {
+			got, err := room.StartTeamTurn(current, "team1", "")
+			if err != nil || got != want {
+				t.Fatalf("Expected %s, got %s (%v)", want, got, err)
+			}
+			current = got
+		}
+		if _, err := room.StartTeamTurn("stale", "team2", ""); err == nil {
+			t.Error("Expected state mismatch to fail")
+		}
+	})
+
+	t.Run("TeamTimeAccumulates", func(t *testing.T) {
+		room := setupTeamRoom(t)
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 2*time.Second)
+		room.SetCurrentTurn("client1", "client3", "")
+		backdateTurn(room, 3*time.Second)
+		room.SetCurrentTurn("client3", "client2", "")
+
+		team, _ := room.GetTeam("team1")
+		if !withinMs(team.TotalTurnTime, 5000) {
+			t.Errorf("Expected ~5000ms for team1, got %d", team.TotalTurnTime)
+		}
+		current, ok := room.GetCurrentTeam()
+		if !ok || current.TeamID != "team2" {
+			t.Errorf("Expected team2 to be active, got %+v", current)
+		}
+	})
+
+	t.Run("TeammatesShareTurnControl", func(t *testing.T) {
+		room := setupTeamRoom(t)
+		room.SetSettings(RoomSettings{TurnControl: TurnControlCurrentPlayer})
+		room.SetCurrentTurn("", "client1", "")
+
+		if err := room.CheckTurnControl("client3"); err != nil {
+			t.Errorf("Expected teammate to share the turn, got %v", err)
+		}
+		if err := room.CheckTurnControl("client2"); err == nil {
+			t.Error("Expected opponent to be refused")
+		}
+	})
+
+	t.Run("UndoRestoresTeamTime", func(t *testing.T) {
+		room := setupTeamRoom(t)
+		room.SetSettings(RoomSettings{UndoDepth: 3})
+		room.SetCurrentTurn("", "client1", "")
+		backdateTurn(room, 2*time.Second)
+		room.SetCurrentTurn("client1", "client2", "")
+
+		if err := room.UndoTurn("client2", "client1"); err != nil {
+			t.Fatalf("UndoTurn failed: %v", err)
+		}
+		if team, _ := room.GetTeam("team1"); team.TotalTurnTime != 0 {
+			t.Errorf("Expected team time to be given back, got %d", team.TotalTurnTime)
+		}
+	})
+}
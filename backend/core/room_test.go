@@ -289,7 +289,7 @@ func TestRoom(t *testing.T) {
 			room.AddClient(client2)
 
 			// Set initial turn
-			if !room.SetCurrentTurn("", "client1") {
+			if room.SetCurrentTurn("", "client1", "") != nil {
 				t.Error("Expected SetCurrentTurn to succeed for first turn")
 			}
 
@@ -307,7 +307,7 @@ func TestRoom(t *testing.T) {
 			time.Sleep(10 * time.Millisecond)
 
 			// Change turn
-			if !room.SetCurrentTurn("client1", "client2") {
+			if room.SetCurrentTurn("client1", "client2", "") != nil {
 				t.Error("Expected SetCurrentTurn to succeed for turn change")
 			}
 
@@ -329,7 +329,7 @@ func TestRoom(t *testing.T) {
 
 		t.Run("ClientNotFound", func(t *testing.T) {
 			room := NewRoom("TEST123")
-			if room.SetCurrentTurn("", "nonexistent") {
+			if room.SetCurrentTurn("", "nonexistent", "") == nil {
 				t.Error("Expected SetCurrentTurn to fail for non-existent client")
 			}
 		})
@@ -342,10 +342,10 @@ func TestRoom(t *testing.T) {
 			room.AddClient(client2)
 
 			// Set initial turn
-			room.SetCurrentTurn("", "client1")
+			room.SetCurrentTurn("", "client1", "")
 
 			// Try to set turn with wrong expectedCurrentTurn
-			if room.SetCurrentTurn("wrong", "client2") {
+			if room.SetCurrentTurn("wrong", "client2", "") == nil {
 				t.Error("Expected SetCurrentTurn to fail for state mismatch")
 			}
 
@@ -364,13 +364,13 @@ func TestRoom(t *testing.T) {
 			room.AddClient(client)
 
 			// Set turn
-			room.SetCurrentTurn("", "client1")
+			room.SetCurrentTurn("", "client1", "")
 
 			initialTotalTime := client.TotalTurnTime
 			time.Sleep(10 * time.Millisecond)
 
 			// Clear turn
-			room.ClearCurrentTurn()
+			room.ClearCurrentTurn("")
 
 			turn := room.GetCurrentTurn()
 			if turn != "" {
@@ -391,7 +391,7 @@ func TestRoom(t *testing.T) {
 		t.Run("WithoutTurn", func(t *testing.T) {
 			room := NewRoom("TEST123")
 			// Should not panic
-			room.ClearCurrentTurn()
+			room.ClearCurrentTurn("")
 
 			turn := room.GetCurrentTurn()
 			if turn != "" {
@@ -438,7 +438,7 @@ func TestRoom(t *testing.T) {
 			room.AddClient(client2)
 
 			// Set client1's turn
-			room.SetCurrentTurn("", "client1")
+			room.SetCurrentTurn("", "client1", "")
 			initialTotalTime := client1.TotalTurnTime
 			time.Sleep(10 * time.Millisecond)
 
@@ -535,12 +535,12 @@ func TestRoom(t *testing.T) {
 					currentTurn := room.GetCurrentTurn()
 					// Only try to set if no turn is active, to reduce conflicts
 					if currentTurn == "" {
-						room.SetCurrentTurn("", "client1")
+						room.SetCurrentTurn("", "client1", "")
 					} else {
-						room.SetCurrentTurn(currentTurn, "client2")
+						room.SetCurrentTurn(currentTurn, "client2", "")
 					}
 					// Clear turn - may fail if another goroutine changed it, that's ok
-					room.ClearCurrentTurn()
+					room.ClearCurrentTurn("")
 				}
 				done <- true
 			}(i)
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"errors"
+	"turn-tracker/backend/types"
+)
+
+var (
+	// ErrGameEnded is returned when a change is refused because the game has ended
+	ErrGameEnded = errors.New("Game has ended")
+	// ErrGamePaused is returned when a turn change is refused because time is frozen
+	ErrGamePaused = errors.New("Game is paused")
+	// ErrSimultaneousMode is returned when a single player's turn is changed in a simultaneous room
+	// (those rooms use StartPhase and MarkReady instead)
+	ErrSimultaneousMode = errors.New("Room is in simultaneous mode")
+)
+
+// ErrorCode returns the error code for an error returned by a room method
+// Errors without a code of their own get fallback
+func ErrorCode(err error, fallback string) string {
+	var turnControl *TurnControlError
+	switch {
+	case errors.Is(err, ErrGameEnded):
+		return types.ErrorCodeGameEnded
+	case errors.Is(err, ErrGamePaused):
+		return types.ErrorCodeGamePaused
+	case errors.Is(err, ErrTurnStateMismatch):
+		return types.ErrorCodeStateMismatch
+	case errors.As(err, &turnControl):
+		return types.ErrorCodeTurnControl
+	}
+	return fallback
+}
+
+// SendRoomError sends the client an error for a request a room method refused, coded by ErrorCode
+func (c *Client) SendRoomError(err error, fallback string) {
+	errorMsg, _ := types.NewCodedErrorMessage(ErrorCode(err, fallback), err.Error())
+	c.SafeSend(errorMsg)
+}
+
+// TurnControlError is returned when the room's turn control policy does not let a client change the turn
+type TurnControlError struct {
+	Policy string // The room's turn control policy
+}
+
+func (e *TurnControlError) Error() string {
+	switch e.Policy {
+	case TurnControlCurrentPlayer:
+		return "Only the current player can change the turn"
+	case TurnControlHost:
+		return "Only the host can change the turn"
+	default:
+		return "Only the current player or the host can change the turn"
+	}
+}
+
+// CheckTurnControl checks whether the room's turn control policy lets the client change the turn (thread-safe read)
+// The current player may only hand their turn off; with no turn active, anyone the policy does not
+// restrict to the host may start one
+// Returns a *TurnControlError if the client is not allowed
+func (r *Room) CheckTurnControl(clientID string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.checkTurnControlLocked(clientID)
+}
+
+// checkNotEndedLocked checks that the room can still change
+// Once the game is over the room stays readable but nothing can change
+// Returns ErrGameEnded if the game has ended
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) checkNotEndedLocked() error {
+	if r.ended != nil {
+		return ErrGameEnded
+	}
+	return nil
+}
+
+// checkTurnChangeLocked checks whether changedBy may change the turn right now
+// The game must be running - time is frozen while paused - and the room's turn control policy
+// must let the client change the turn
+// Returns ErrGameEnded, ErrGamePaused or a *TurnControlError if not
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) checkTurnChangeLocked(changedBy string) error {
+	if err := r.checkNotEndedLocked(); err != nil {
+		return err
+	}
+	if r.pausedAt != nil {
+		return ErrGamePaused
+	}
+	return r.checkTurnControlLocked(changedBy)
+}
+
+// checkTurnOrderChangeLocked checks whether changedBy may change the seating order
+// The order can change while paused, but not once the game is over, and the room's turn control
+// policy decides who may change it
+// Returns ErrGameEnded or a *TurnControlError if not
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) checkTurnOrderChangeLocked(changedBy string) error {
+	if err := r.checkNotEndedLocked(); err != nil {
+		return err
+	}
+	return r.checkTurnControlLocked(changedBy)
+}
+
+// checkSingleTurnChangeLocked is checkTurnChangeLocked for changes that give one player the turn
+// Returns ErrSimultaneousMode in simultaneous rooms
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) checkSingleTurnChangeLocked(changedBy string) error {
+	if r.settings.TurnMode == TurnModeSimultaneous {
+		return ErrSimultaneousMode
+	}
+	return r.checkTurnChangeLocked(changedBy)
+}
+
+// checkTurnControlLocked applies the room's turn control policy (see CheckTurnControl)
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) checkTurnControlLocked(clientID string) error {
+	policy := r.settings.TurnControl
+	isHost := clientID != "" && r.host == clientID
+	// Team turns are shared, so teammates of the current player count as current too
+	isCurrent := r.CurrentTurn == "" || r.CurrentTurn == clientID || r.sameTeamLocked(r.CurrentTurn, clientID)
+
+	allowed := true
+	switch policy {
+	case TurnControlCurrentPlayer:
+		allowed = isCurrent
+	case TurnControlHost:
+		allowed = isHost
+	case TurnControlCurrentPlayerOrHost:
+		allowed = isCurrent || isHost
+	}
+	if !allowed {
+		return &TurnControlError{Policy: policy}
+	}
+	return nil
+}
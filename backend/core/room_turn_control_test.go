@@ -0,0 +1,95 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+// setupTurnControlRoom creates a three-player room (client1 hosts) with the given turn control policy
+func setupTurnControlRoom(t *testing.T, policy string) *Room {
+	t.Helper()
+	room := NewRoom("TEST123")
+	if err := room.SetSettings(RoomSettings{TurnControl: policy}); err != nil {
+		t.Fatalf("Invalid settings: %v", err)
+	}
+	room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+	room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+	room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+	return room
+}
+
+func TestRoomTurnControl(t *testing.T) {
+	t.Run("DefaultsToAnyone", func(t *testing.T) {
+		room := setupTurnControlRoom(t, "")
+		if room.GetSettings().TurnControl != TurnControlAnyone {
+			t.Errorf("Expected default %s, got %s", TurnControlAnyone, room.GetSettings().TurnControl)
+		}
+		room.SetCurrentTurn("", "client2", "")
+		if err := room.CheckTurnControl("client3"); err != nil {
+			t.Errorf("Expected anyone to change the turn, got %v", err)
+		}
+	})
+
+	// client2 has the turn; client1 is the host and client3 is a bystander
+	tests := []struct {
+		policy  string
+		allowed map[string]bool
+	}{
+		{TurnControlAnyone, map[string]bool{"client1": true, "client2": true, "client3": true}},
+		{TurnControlCurrentPlayer, map[string]bool{"client1": false, "client2": true, "client3": false}},
+		{TurnControlHost, map[string]bool{"client1": true, "client2": false, "client3": false}},
+		{TurnControlCurrentPlayerOrHost, map[string]bool{"client1": true, "client2": true, "client3": false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			room := setupTurnControlRoom(t, tt.policy)
+			room.SetCurrentTurn("", "client2", "")
+			for clientID, allowed := range tt.allowed {
+				err := room.CheckTurnControl(clientID)
+				if (err == nil) != allowed {
+					t.Errorf("CheckTurnControl(%s) = %v, want allowed=%v", clientID, err, allowed)
+				}
+			}
+		})
+	}
+
+	t.Run("AnyoneStartsFirstTurnUnderCurrentPlayer", func(t *testing.T) {
+		room := setupTurnControlRoom(t, TurnControlCurrentPlayer)
+		if err := room.CheckTurnControl("client3"); err != nil {
+			t.Errorf("Expected anyone to start a turn when none is active, got %v", err)
+		}
+	})
+
+	t.Run("HostOnlyWithoutTurn", func(t *testing.T) {
+		room := setupTurnControlRoom(t, TurnControlHost)
+		if err := room.CheckTurnControl("client3"); err == nil {
+			t.Error("Expected only the host to start a turn")
+		}
+	})
+
+	t.Run("TypedError", func(t *testing.T) {
+		room := setupTurnControlRoom(t, TurnControlHost)
+		err := room.CheckTurnControl("client2")
+		var controlErr *TurnControlError
+		if !errors.As(err, &controlErr) {
+			t.Fatalf("Expected *TurnControlError, got %T", err)
+		}
+		if controlErr.Policy != TurnControlHost {
+			t.Errorf("Expected policy %s, got %s", TurnControlHost, controlErr.Policy)
+		}
+		if err.Error() != "Only the host can change the turn" {
+			t.Errorf("Unexpected message: %s", err.Error())
+		}
+	})
+
+	t.Run("FollowsHostTransfer", func(t *testing.T) {
+		room := setupTurnControlRoom(t, TurnControlHost)
+		room.TransferHost("client3")
+		if err := room.CheckTurnControl("client3"); err != nil {
+			t.Errorf("Expected new host to control turns, got %v", err)
+		}
+		if err := room.CheckTurnControl("client1"); err == nil {
+			t.Error("Expected previous host to lose turn control")
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// MaxUndoDepth caps the configurable number of undoable turn changes
+	MaxUndoDepth = 20
+	// MaxUndoWindow caps the configurable time a turn change stays undoable
+	MaxUndoWindow = time.Hour
+)
+
+// ErrTurnStateMismatch is returned when the client's view of the current turn is stale
+var ErrTurnStateMismatch = errors.New("Turn state mismatch")
+
+// turnUndo is the room state from just before a manual turn change
+type turnUndo struct {
+	at            int64 // Wall-clock Unix timestamp in nanoseconds of the change
+	round         int
+	currentTurn   string
+	turnStartTime *int64
+	turnStartedAt int64
+	lastTurn      string
+	totals        map[string]int64           // TotalTurnTime per client in the room
+	members       map[string]memberTurnState // Turn state of every member (clocks, round stats, passes)
+	teams         map[string]team            // Copies of every team (turn time totals and rotation)
+	roundPlayers  []string
+	historySeq    int
+}
+
+// memberTurnState is the part of a member record a turn change can alter
+// Presence, team, score and counters are not part of the turn and are never undone
+type memberTurnState struct {
+	timeBankMs  int64
+	flagged     bool
+	roundTurns  int
+	roundTimeMs int64
+	passed      bool
+	turnTimesMs []int64
+}
+
+// UndoTurn reverts the most recent manual turn change (thread-safe)
+// Restores the previous turn and its start time and gives back the time credited by the change
+// The undone turns stay in the history; an undo record naming them is appended
+// Returns an error from checkTurnChangeLocked if the turn may not change, ErrTurnStateMismatch if
+// expectedCurrentTurn is stale, or an error explaining why nothing can be undone
+func (r *Room) UndoTurn(expectedCurrentTurn, undoneBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTurnChangeLocked(undoneBy); err != nil {
+		return err
+	}
+	if r.settings.UndoDepth == 0 {
+		return errors.New("Undo is disabled in this room")
+	}
+	if r.CurrentTurn != expectedCurrentTurn {
+		return ErrTurnStateMismatch
+	}
+	if len(r.undo) == 0 {
+		return errors.New("Nothing to undo")
+	}
+
+	last := r.undo[len(r.undo)-1]
+	if window := r.settings.UndoWindowMs; window > 0 && time.Now().UnixNano()-last.at > window*int64(time.Millisecond) {
+		return errors.New("Undo window has expired")
+	}
+	if last.round != r.round {
+		return errors.New("Cannot undo past the end of a round")
+	}
+	r.undo = r.undo[:len(r.undo)-1]
+
+	r.CurrentTurn = last.currentTurn
+	r.TurnStartTime = last.turnStartTime
+	r.turnStartedAt = last.turnStartedAt
+	r.lastTurn = last.lastTurn
+	r.roundPlayers = last.roundPlayers
+	for clientID, total := range last.totals {
+		if client := r.Clients[clientID]; client != nil {
+			client.TotalTurnTime = total
+		}
+	}
+	for clientID, saved := range last.members {
+		if member := r.members[clientID]; member != nil {
+			member.TimeBankMs = saved.timeBankMs
+			member.Flagged = saved.flagged
+			member.RoundTurns = saved.roundTurns
+			member.RoundTimeMs = saved.roundTimeMs
+			member.Passed = saved.passed
+			member.TurnTimesMs = saved.turnTimesMs
+		}
+	}
+	for _, t := range r.teams {
+		if saved, ok := last.teams[t.id]; ok {
+			*t = saved
+		}
+	}
+	r.appendUndoHistoryLocked(undoneBy, last.historySeq)
+	r.turnGen++
+	return nil
+}
+
+// saveUndoLocked remembers the state before a turn change so it can be undone
+// Only manual changes are undoable - any other change discards the undo stack
+// MUST be called with r.mu.Lock() held, before the change is applied
+func (r *Room) saveUndoLocked(end turnEnd) {
+	if end.reason != TurnEndManual || r.settings.UndoDepth == 0 {
+		r.undo = nil
+		return
+	}
+
+	undo := turnUndo{
+		at:            time.Now().UnixNano(),
+		round:         r.round,
+		currentTurn:   r.CurrentTurn,
+		turnStartedAt: r.turnStartedAt,
+		lastTurn:      r.lastTurn,
+		totals:        make(map[string]int64, len(r.Clients)),
+		members:       make(map[string]memberTurnState, len(r.members)),
+		teams:         make(map[string]team, len(r.teams)),
+		roundPlayers:  append([]string(nil), r.roundPlayers...),
+		historySeq:    r.historySeq,
+	}
+	if r.TurnStartTime != nil {
+		start := *r.TurnStartTime
+		undo.turnStartTime = &start
+	}
+	for clientID, client := range r.Clients {
+		undo.totals[clientID] = client.TotalTurnTime
+	}
+	for clientID, member := range r.members {
+		undo.members[clientID] = memberTurnState{
+			timeBankMs:  member.TimeBankMs,
+			flagged:     member.Flagged,
+			roundTurns:  member.RoundTurns,
+			roundTimeMs: member.RoundTimeMs,
+			passed:      member.Passed,
+			turnTimesMs: append([]int64(nil), member.TurnTimesMs...),
+		}
+	}
+	for _, t := range r.teams {
+		undo.teams[t.id] = *t
+	}
+
+	if len(r.undo) >= r.settings.UndoDepth {
+		r.undo = append(r.undo[:0:0], r.undo[len(r.undo)-r.settings.UndoDepth+1:]...)
+	}
+	r.undo = append(r.undo, undo)
+}
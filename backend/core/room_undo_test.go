@@ -0,0 +1,154 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func setupUndoRoom(t *testing.T, settings RoomSettings) (*Room, *Client, *Client) {
+	t.Helper()
+	room := NewRoom("TEST123")
+	if err := room.SetSettings(settings); err != nil {
+		t.Fatalf("Invalid settings: %v", err)
+	}
+	client1 := createTestClient("client1", "Alice", "#FF0000")
+	client2 := createTestClient("client2", "Bob", "#00FF00")
+	room.AddClient(client1)
+	room.AddClient(client2)
+	room.AddClient(createTestClient("client3", "Carol", "#0000FF"))
+	return room, client1, client2
+}
+
+func TestRoomUndo(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		room, _, _ := setupUndoRoom(t, RoomSettings{})
+		room.SetCurrentTurn("", "client1", "client1")
+		if err := room.UndoTurn("client1", "client1"); err == nil {
+			t.Error("Expected undo to be disabled")
+		}
+	})
+
+	t.Run("RestoresPreviousTurn", func(t *testing.T) {
+		room, client1, client2 := setupUndoRoom(t, RoomSettings{UndoDepth: 3})
+		room.SetCurrentTurn("", "client1", "client1")
+		backdateTurn(room, 4*time.Second)
+		startBefore := room.GetTurnStartTime()
+
+		// Mis-tap: client3 gets the turn instead of client2
+		room.SetCurrentTurn("client1", "client3", "client1")
+		if !withinMs(client1.TotalTurnTime, 4000) {
+			t.Fatalf("Expected ~4000ms credited, got %d", client1.TotalTurnTime)
+		}
+
+		if err := room.UndoTurn("client3", "client1"); err != nil {
+			t.Fatalf("Expected undo to succeed: %v", err)
+		}
+		if room.GetCurrentTurn() != "client1" || room.GetTurnStartTime() != startBefore {
+			t.Errorf("Expected client1's turn to be restored, got %s started at %d", room.GetCurrentTurn(), room.GetTurnStartTime())
+		}
+		if client1.TotalTurnTime != 0 {
+			t.Errorf("Expected credited time to be given back, got %d", client1.TotalTurnTime)
+		}
+		// The undone turn stays in the history, followed by a record of the undo
+		history := room.GetHistory(0, 0)
+		if history.Total != 2 {
+			t.Fatalf("Expected the undone turn and an undo record, got %d records", history.Total)
+		}
+		undo := history.Turns[1]
+		if undo.EndReason != TurnEndUndo || undo.ClientID != "client1" || len(undo.Undoes) != 1 || undo.Undoes[0] != history.Turns[0].Seq {
+			t.Errorf("Expected an undo record for seq %d, got %+v", history.Turns[0].Seq, undo)
+		}
+
+		// Play continues normally after the undo
+		room.SetCurrentTurn("client1", "client2", "client1")
+		if client2.TotalTurnTime != 0 || !withinMs(client1.TotalTurnTime, 4000) {
+			t.Errorf("Unexpected totals after redo: %d, %d", client1.TotalTurnTime, client2.TotalTurnTime)
+		}
+	})
+
+	t.Run("RestoresClocks", func(t *testing.T) {
+		room, _, _ := setupUndoRoom(t, RoomSettings{UndoDepth: 1, ClockBankMs: 60000})
+		room.SetCurrentTurn("", "client1", "client1")
+		backdateTurn(room, 5*time.Second)
+		room.SetCurrentTurn("client1", "client2", "client1")
+		room.UndoTurn("client2", "client1")
+
+		if got := clockFor(t, room, "client1").RemainingMs; got != 60000 {
+			t.Errorf("Expected full bank after undo, got %d", got)
+		}
+	})
+
+	t.Run("DepthCap", func(t *testing.T) {
+		room, _, _ := setupUndoRoom(t, RoomSettings{UndoDepth: 2})
+		room.AdvanceTurn("", "client1")
+		room.AdvanceTurn("client1", "client1")
+		room.AdvanceTurn("client2", "client1")
+
+		if err := room.UndoTurn("client3", "client1"); err != nil {
+			t.Fatalf("Expected first undo to succeed: %v", err)
+		}
+		if err := room.UndoTurn("client2", "client1"); err != nil {
+			t.Fatalf("Expected second undo to succeed: %v", err)
+		}
+		if err := room.UndoTurn("client1", "client1"); err == nil {
+			t.Error("Expected third undo to exceed the depth")
+		}
+	})
+
+	t.Run("Window", func(t *testing.T) {
+		room, _, _ := setupUndoRoom(t, RoomSettings{UndoDepth: 1, UndoWindowMs: 1000})
+		room.SetCurrentTurn("", "client1", "client1")
+
+		room.mu.Lock()
+		room.undo[0].at -= int64(2 * time.Second)
+		room.mu.Unlock()
+
+		if err := room.UndoTurn("client1", "client1"); err == nil {
+			t.Error("Expected undo after the window to fail")
+		}
+	})
+
+	t.Run("StateMismatch", func(t *testing.T) {
+		room, _, _ := setupUndoRoom(t, RoomSettings{UndoDepth: 1})
+		room.SetCurrentTurn("", "client1", "client1")
+		if err := room.UndoTurn("client2", "client1"); !errors.Is(err, ErrTurnStateMismatch) {
+			t.Errorf("Expected ErrTurnStateMismatch, got %v", err)
+		}
+	})
+
+	t.Run("NotAcrossRoundBoundary", func(t *testing.T) {
+		room, _, _ := setupUndoRoom(t, RoomSettings{UndoDepth: 5})
+		room.AdvanceTurn("", "client1")
+		room.AdvanceTurn("client1", "client1")
+		room.AdvanceTurn("client2", "client1")
+		room.AdvanceTurn("client3", "client1") // Completes round 1
+
+		if err := room.UndoTurn("client1", "client1"); err == nil {
+			t.Error("Expected undo past the end of a round to fail")
+		}
+	})
+
+	t.Run("KeepsPresence", func(t *testing.T) {
+		room, _, client2 := setupUndoRoom(t, RoomSettings{UndoDepth: 3})
+		room.SetCurrentTurn("", "client1", "client1")
+		room.SetCurrentTurn("client1", "client3", "client1")
+		room.MarkAway(client2)
+
+		if err := room.UndoTurn("client3", "client1"); err == nil {
+			t.Error("Expected undo stack to be cleared when a player goes away")
+		}
+		if !room.IsAway("client2") {
+			t.Error("Expected client2 to stay away")
+		}
+	})
+
+	t.Run("DisconnectClearsUndo", func(t *testing.T) {
+		room, _, _ := setupUndoRoom(t, RoomSettings{UndoDepth: 3})
+		room.SetCurrentTurn("", "client1", "client1")
+		room.RemoveClient("client3")
+		if err := room.UndoTurn("client1", "client1"); err == nil {
+			t.Error("Expected undo stack to be cleared when a player leaves")
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package core
+
+import "log"
+
+const (
+	// MinRoomCapacity and MaxRoomCapacity bound the configurable number of seats
+	MinRoomCapacity = 2
+	MaxRoomCapacity = 16
+)
+
+// AddClientOrWait seats the client, or puts it at the back of the waitlist if the room is full (thread-safe)
+// Returns true if the client was seated, otherwise its waitlist position (starting at 1)
+// Returns false and position 0 if the client is already in the room
+func (r *Room) AddClientOrWait(client *Client) (bool, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Clients[client.ClientID] != nil || r.spectators[client.ClientID] != nil {
+		return false, 0 // Already in room
+	}
+	if position := r.waitlistPositionLocked(client.ClientID); position > 0 {
+		return false, position // Already waiting
+	}
+
+	if r.isFullLocked() {
+		r.waitlist = append(r.waitlist, client)
+		return false, len(r.waitlist)
+	}
+
+	r.seatClientLocked(client)
+	return true, 0
+}
+
+// LeaveWaitlist takes a client off the waitlist (thread-safe)
+// Returns true if the client was waiting
+func (r *Room) LeaveWaitlist(clientID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	position := r.waitlistPositionLocked(clientID)
+	if position == 0 {
+		return false
+	}
+	r.waitlist = append(r.waitlist[:position-1], r.waitlist[position:]...)
+	return true
+}
+
+// AdmitWaitlisted seats waitlisted clients, oldest first, while there are free seats (thread-safe)
+// Returns the admitted clients in the order they were seated
+func (r *Room) AdmitWaitlisted() []*Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var admitted []*Client
+	for len(r.waitlist) > 0 && !r.isFullLocked() {
+		client := r.waitlist[0]
+		r.waitlist = r.waitlist[1:]
+		r.seatClientLocked(client)
+		admitted = append(admitted, client)
+	}
+	return admitted
+}
+
+// ListWaitlist returns the waitlisted clients in order (thread-safe read)
+// A client's waitlist position is its index plus one
+func (r *Room) ListWaitlist() []*Client {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.waitlist) == 0 {
+		return nil
+	}
+	waitlist := make([]*Client, len(r.waitlist))
+	copy(waitlist, r.waitlist)
+	return waitlist
+}
+
+// isFullLocked reports whether every seat is taken (rooms without a capacity are never full)
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) isFullLocked() bool {
+	return r.settings.Capacity > 0 && len(r.Clients) >= r.settings.Capacity
+}
+
+// waitlistPositionLocked returns the client's waitlist position (starting at 1), or 0 if it is not waiting
+// MUST be called with r.mu.RLock() or r.mu.Lock() held
+func (r *Room) waitlistPositionLocked(clientID string) int {
+	for i, client := range r.waitlist {
+		if client.ClientID == clientID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// LeaveWaitlist takes the client off the waitlist it is on, if any
+// The clients behind it move up, so they are told their new positions
+func (h *Hub) LeaveWaitlist(client *Client) {
+	roomID := client.WaitingRoomID
+	if roomID == "" {
+		return
+	}
+	client.WaitingRoomID = ""
+
+	room := h.GetRoom(roomID)
+	if room == nil || !room.LeaveWaitlist(client.ClientID) {
+		return
+	}
+	if h.OnWaitlistChanged != nil {
+		h.OnWaitlistChanged(roomID)
+	}
+}
+
+// admitWaitlisted gives free seats to waitlisted clients
+// Each client is moved into the room and announced on its own goroutine (see Client.Queue), so it is
+// never listed as a player before its own room state says so
+// Returns the number of clients admitted
+func (h *Hub) admitWaitlisted(room *Room) int {
+	admitted := room.AdmitWaitlisted()
+	if len(admitted) == 0 {
+		return 0
+	}
+
+	roomID := room.ID
+	for _, client := range admitted {
+		if !client.Queue(func(c *Client) { h.takeAdmittedSeat(c, roomID) }) {
+			// Disconnected while being admitted - the seat goes to the next in line
+			h.RemoveClientFromRoom(roomID, client.ClientID, "disconnected from the waitlist")
+		}
+	}
+	if h.OnWaitlistChanged != nil {
+		h.OnWaitlistChanged(room.ID)
+	}
+	return len(admitted)
+}
+
+// takeAdmittedSeat moves a client admitted from the waitlist into the room and announces it
+// Runs on the client's goroutine; if the client stopped waiting in the meantime, it gives the seat back
+func (h *Hub) takeAdmittedSeat(client *Client, roomID string) {
+	if client.WaitingRoomID != roomID {
+		h.RemoveClientFromRoom(roomID, client.ClientID, "no longer waiting")
+		return
+	}
+	client.RoomID = roomID
+	client.WaitingRoomID = ""
+	client.Spectator = false
+
+	log.Printf("Client %s moved from the waitlist into room %s", client.ClientID, roomID)
+	if h.OnWaitlistAdmitted != nil {
+		h.OnWaitlistAdmitted(roomID, client)
+	}
+}
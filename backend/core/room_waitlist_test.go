@@ -0,0 +1,171 @@
+package core
+
+import "testing"
+
+// setupFullRoom creates a room with two seats, both taken
+func setupFullRoom(t *testing.T) *Room {
+	t.Helper()
+	room := NewRoom("TEST123")
+	if err := room.SetSettings(RoomSettings{Capacity: 2}); err != nil {
+		t.Fatalf("Invalid settings: %v", err)
+	}
+	room.AddClientOrWait(createTestClient("client1", "Alice", "#FF0000"))
+	room.AddClientOrWait(createTestClient("client2", "Bob", "#00FF00"))
+	return room
+}
+
+func TestRoomWaitlist(t *testing.T) {
+	t.Run("UnlimitedByDefault", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		for _, id := range []string{"client1", "client2", "client3"} {
+			if seated, _ := room.AddClientOrWait(createTestClient(id, id, "#FF0000")); !seated {
+				t.Errorf("Expected %s to be seated", id)
+			}
+		}
+	})
+
+	t.Run("FullRoomWaitlists", func(t *testing.T) {
+		room := setupFullRoom(t)
+
+		for i, id := range []string{"client3", "client4"} {
+			seated, position := room.AddClientOrWait(createTestClient(id, id, "#0000FF"))
+			if seated || position != i+1 {
+				t.Errorf("Expected %s at position %d, got seated=%v position=%d", id, i+1, seated, position)
+			}
+		}
+		if len(room.ListPeerInfo()) != 2 {
+			t.Errorf("Expected 2 seated players, got %d", len(room.ListPeerInfo()))
+		}
+
+		// Asking again keeps the place in line
+		if _, position := room.AddClientOrWait(createTestClient("client3", "client3", "#0000FF")); position != 1 {
+			t.Errorf("Expected client3 to stay at position 1, got %d", position)
+		}
+	})
+
+	t.Run("AlreadySeated", func(t *testing.T) {
+		room := setupFullRoom(t)
+		if seated, position := room.AddClientOrWait(createTestClient("client1", "Alice", "#FF0000")); seated || position != 0 {
+			t.Errorf("Expected re-sync for a seated player, got seated=%v position=%d", seated, position)
+		}
+	})
+
+	t.Run("AdmitsInOrder", func(t *testing.T) {
+		room := setupFullRoom(t)
+		room.AddClientOrWait(createTestClient("client3", "Carol", "#0000FF"))
+		room.AddClientOrWait(createTestClient("client4", "Dave", "#FFFF00"))
+
+		if admitted := room.AdmitWaitlisted(); admitted != nil {
+			t.Errorf("Expected nobody admitted while full, got %d", len(admitted))
+		}
+
+		room.RemoveClient("client1")
+		admitted := room.AdmitWaitlisted()
+		if len(admitted) != 1 || admitted[0].ClientID != "client3" {
+			t.Fatalf("Expected client3 admitted, got %v", admitted)
+		}
+		waitlist := room.ListWaitlist()
+		if len(waitlist) != 1 || waitlist[0].ClientID != "client4" {
+			t.Errorf("Expected client4 left waiting, got %v", waitlist)
+		}
+	})
+
+	t.Run("LeaveWaitlist", func(t *testing.T) {
+		room := setupFullRoom(t)
+		room.AddClientOrWait(createTestClient("client3", "Carol", "#0000FF"))
+		room.AddClientOrWait(createTestClient("client4", "Dave", "#FFFF00"))
+
+		if !room.LeaveWaitlist("client3") {
+			t.Fatal("Expected client3 to leave the waitlist")
+		}
+		if room.LeaveWaitlist("client3") {
+			t.Error("Expected second leave to do nothing")
+		}
+		if waitlist := room.ListWaitlist(); len(waitlist) != 1 || waitlist[0].ClientID != "client4" {
+			t.Errorf("Expected client4 to move up, got %v", waitlist)
+		}
+	})
+
+	t.Run("CapacityFixedOnceSeated", func(t *testing.T) {
+		room := setupFullRoom(t)
+		if err := room.SetSettings(RoomSettings{Capacity: 4}); err == nil {
+			t.Error("Expected capacity change to be rejected")
+		}
+		if err := room.SetSettings(RoomSettings{Capacity: 2, TurnTimeLimitMs: 1000}); err != nil {
+			t.Errorf("Expected other settings to change, got %v", err)
+		}
+	})
+}
+
+func TestHubWaitlist(t *testing.T) {
+	t.Run("SeatOpensOnRemove", func(t *testing.T) {
+		hub := NewHub()
+		defer hub.Shutdown()
+		room := setupFullRoom(t)
+		hub.AddRoom(room.ID, room)
+
+		waiting := createTestClient("client3", "Carol", "#0000FF")
+		room.AddClientOrWait(waiting)
+		waiting.WaitingRoomID = room.ID
+
+		var admitted []string
+		hub.OnWaitlistAdmitted = func(roomID string, client *Client) { admitted = append(admitted, client.ClientID) }
+
+		hub.RemoveClientFromRoom(room.ID, "client2", "test")
+		if len(admitted) != 0 {
+			t.Fatalf("Expected no announcement before client3's room state is set, got %v", admitted)
+		}
+
+		waiting.runQueued() // The client's own goroutine moves it into the room, then announces it
+		if len(admitted) != 1 || admitted[0] != "client3" {
+			t.Fatalf("Expected client3 admitted, got %v", admitted)
+		}
+		if waiting.RoomID != room.ID || waiting.WaitingRoomID != "" {
+			t.Errorf("Expected client3 in the room and off the waitlist, got room=%q waiting=%q", waiting.RoomID, waiting.WaitingRoomID)
+		}
+	})
+
+	t.Run("DisconnectedClientPassesSeatOn", func(t *testing.T) {
+		hub := NewHub()
+		defer hub.Shutdown()
+		room := setupFullRoom(t)
+		hub.AddRoom(room.ID, room)
+
+		gone := createTestClient("client3", "Carol", "#0000FF")
+		room.AddClientOrWait(gone)
+		gone.WaitingRoomID = room.ID
+		gone.closeQueue() // Its ReadPump has exited
+		next := createTestClient("client4", "Dan", "#FFFF00")
+		room.AddClientOrWait(next)
+		next.WaitingRoomID = room.ID
+
+		hub.RemoveClientFromRoom(room.ID, "client2", "test")
+		next.runQueued()
+
+		if room.HasConnection(gone) {
+			t.Error("Expected the disconnected client to give its seat back")
+		}
+		if !room.HasConnection(next) || next.RoomID != room.ID {
+			t.Errorf("Expected client4 to take the seat, got room=%q", next.RoomID)
+		}
+	})
+
+	t.Run("LeaveWaitlistNotifies", func(t *testing.T) {
+		hub := NewHub()
+		defer hub.Shutdown()
+		room := setupFullRoom(t)
+		hub.AddRoom(room.ID, room)
+
+		waiting := createTestClient("client3", "Carol", "#0000FF")
+		room.AddClientOrWait(waiting)
+		waiting.WaitingRoomID = room.ID
+
+		changed := 0
+		hub.OnWaitlistChanged = func(roomID string) { changed++ }
+
+		hub.LeaveWaitlist(waiting)
+		if changed != 1 || waiting.WaitingRoomID != "" || room.ListWaitlist() != nil {
+			t.Errorf("Expected client3 off the waitlist with one notification, got changed=%d", changed)
+		}
+	})
+}
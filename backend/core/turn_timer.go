@@ -0,0 +1,192 @@
+package core
+
+import (
+	"log"
+	"time"
+)
+
+// turnTimer holds the armed timers for a room's active turn
+type turnTimer struct {
+	warning *time.Timer
+	expiry  *time.Timer
+	flag    *time.Timer
+}
+
+// stop stops all timers (safe to call on partially armed timers)
+func (t *turnTimer) stop() {
+	if t.warning != nil {
+		t.warning.Stop()
+	}
+	if t.expiry != nil {
+		t.expiry.Stop()
+	}
+	if t.flag != nil {
+		t.flag.Stop()
+	}
+}
+
+// turnTiming is a snapshot of the active turn used to arm timers
+type turnTiming struct {
+	gen      uint64        // Turn generation the timers belong to
+	clientID string        // Player whose turn is being timed
+	deadline time.Time     // When the turn reaches its time limit (zero if no limit)
+	warning  time.Duration // How long before the deadline to warn (0 = no warning)
+	flagAt   time.Time     // When the player's chess clock runs out (zero if no clock)
+}
+
+// turnTiming returns the timing of the active turn
+// Returns false if no turn is active or nothing about the turn needs a timer
+func (r *Room) turnTiming() (turnTiming, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.CurrentTurn == "" || r.TurnStartTime == nil || r.pausedAt != nil {
+		return turnTiming{}, false // Nothing to time, or time is frozen
+	}
+
+	timing := turnTiming{
+		gen:      r.turnGen,
+		clientID: r.CurrentTurn,
+		flagAt:   r.flagAtLocked(),
+	}
+	if r.settings.TurnTimeLimitMs > 0 {
+		start := time.Unix(0, *r.TurnStartTime)
+		timing.deadline = start.Add(time.Duration(r.settings.TurnTimeLimitMs) * time.Millisecond)
+		timing.warning = time.Duration(r.settings.TurnWarningMs) * time.Millisecond
+	}
+	return timing, !timing.deadline.IsZero() || !timing.flagAt.IsZero()
+}
+
+// isTurnGeneration reports whether the turn identified by gen is still the active turn
+func (r *Room) isTurnGeneration(gen uint64) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.turnGen == gen && r.CurrentTurn != ""
+}
+
+// expireTurn applies the room's expiry policy to the turn identified by gen
+// Returns the applied policy, or false if that turn is no longer active
+// The generation check makes a timer that lost a race with SetCurrentTurn or ClearCurrentTurn a no-op
+func (r *Room) expireTurn(gen uint64) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.turnGen != gen || r.CurrentTurn == "" {
+		return "", false
+	}
+
+	policy := r.settings.TurnExpiryPolicy
+	end := turnEnd{reason: TurnEndTimeout} // Ended by the server
+	switch policy {
+	case TurnExpiryEnd:
+		r.clearTurnLocked(end)
+	case TurnExpiryAdvance:
+		r.startTurnLocked(r.nextSeatLocked(r.CurrentTurn), end)
+	}
+	return policy, true
+}
+
+// ScheduleTurnTimers (re)arms the turn_warning, turn_expired and clock_flagged timers for the room's active turn
+// Previously armed timers for the room are stopped, so call this after every turn change
+func (h *Hub) ScheduleTurnTimers(room *Room) {
+	h.turnTimersMu.Lock()
+	defer h.turnTimersMu.Unlock()
+
+	if existing := h.turnTimers[room.ID]; existing != nil {
+		existing.stop()
+		delete(h.turnTimers, room.ID)
+	}
+
+	timing, ok := room.turnTiming()
+	if !ok {
+		return
+	}
+
+	timers := &turnTimer{}
+	if !timing.deadline.IsZero() {
+		if timing.warning > 0 {
+			if warnIn := time.Until(timing.deadline.Add(-timing.warning)); warnIn > 0 {
+				timers.warning = time.AfterFunc(warnIn, func() {
+					h.fireTurnWarning(room, timing)
+				})
+			}
+		}
+		timers.expiry = time.AfterFunc(time.Until(timing.deadline), func() {
+			h.fireTurnExpired(room, timing)
+		})
+	}
+	if !timing.flagAt.IsZero() {
+		timers.flag = time.AfterFunc(time.Until(timing.flagAt), func() {
+			h.fireClockFlagged(room, timing)
+		})
+	}
+	h.turnTimers[room.ID] = timers
+}
+
+// StopTurnTimers stops any armed turn timers for a room
+func (h *Hub) StopTurnTimers(roomID string) {
+	h.turnTimersMu.Lock()
+	defer h.turnTimersMu.Unlock()
+
+	if existing := h.turnTimers[roomID]; existing != nil {
+		existing.stop()
+		delete(h.turnTimers, roomID)
+	}
+}
+
+// stopAllTurnTimers stops every armed turn timer (used during shutdown)
+func (h *Hub) stopAllTurnTimers() {
+	h.turnTimersMu.Lock()
+	defer h.turnTimersMu.Unlock()
+
+	for roomID, timers := range h.turnTimers {
+		timers.stop()
+		delete(h.turnTimers, roomID)
+	}
+}
+
+// fireTurnWarning notifies the room that the active turn is about to expire
+func (h *Hub) fireTurnWarning(room *Room, timing turnTiming) {
+	if h.shutdownCtx.Err() != nil || !room.isTurnGeneration(timing.gen) {
+		return // Shutting down or the turn already changed
+	}
+
+	remainingMs := time.Until(timing.deadline).Milliseconds()
+	if remainingMs < 0 {
+		remainingMs = 0
+	}
+	if h.OnTurnWarning != nil {
+		h.OnTurnWarning(room.ID, timing.clientID, remainingMs)
+	}
+}
+
+// fireTurnExpired applies the expiry policy and notifies the room
+// OnTurnExpired is responsible for broadcasting the resulting turn change
+func (h *Hub) fireTurnExpired(room *Room, timing turnTiming) {
+	if h.shutdownCtx.Err() != nil {
+		return
+	}
+
+	policy, ok := room.expireTurn(timing.gen)
+	if !ok {
+		return // The turn already changed
+	}
+
+	log.Printf("Turn expired for client %s in room %s (policy: %s)", timing.clientID, room.ID, policy)
+	if h.OnTurnExpired != nil {
+		h.OnTurnExpired(room.ID, timing.clientID, policy)
+	}
+}
+
+// fireClockFlagged marks the active player as out of time and notifies the room
+// The turn keeps running - what a flag means is up to the players
+func (h *Hub) fireClockFlagged(room *Room, timing turnTiming) {
+	if h.shutdownCtx.Err() != nil || !room.flagClock(timing.gen) {
+		return // Shutting down or the turn already changed
+	}
+
+	log.Printf("Clock flagged for client %s in room %s", timing.clientID, room.ID)
+	if h.OnClockFlagged != nil {
+		h.OnClockFlagged(room.ID, timing.clientID)
+	}
+}
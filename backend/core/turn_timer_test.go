@@ -0,0 +1,236 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoomSettings(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			settings RoomSettings
+			valid    bool
+		}{
+			{"Empty", RoomSettings{}, true},
+			{"LimitOnly", RoomSettings{TurnTimeLimitMs: 60000}, true},
+			{"LimitWithWarning", RoomSettings{TurnTimeLimitMs: 60000, TurnWarningMs: 10000}, true},
+			{"AdvancePolicy", RoomSettings{TurnTimeLimitMs: 60000, TurnExpiryPolicy: TurnExpiryAdvance}, true},
+			{"NegativeLimit", RoomSettings{TurnTimeLimitMs: -1}, false},
+			{"LimitTooLong", RoomSettings{TurnTimeLimitMs: MaxTurnTimeLimit.Milliseconds() + 1}, false},
+			{"WarningWithoutLimit", RoomSettings{TurnWarningMs: 1000}, false},
+			{"WarningNotShorter", RoomSettings{TurnTimeLimitMs: 1000, TurnWarningMs: 1000}, false},
+			{"UnknownPolicy", RoomSettings{TurnExpiryPolicy: "explode"}, false},
+			{"SimultaneousMode", RoomSettings{TurnMode: TurnModeSimultaneous}, true},
+			{"UnknownTurnMode", RoomSettings{TurnMode: "chaotic"}, false},
+			{"Undo", RoomSettings{UndoDepth: 3, UndoWindowMs: 10000}, true},
+			{"UndoTooDeep", RoomSettings{UndoDepth: MaxUndoDepth + 1}, false},
+			{"NegativeUndoWindow", RoomSettings{UndoDepth: 1, UndoWindowMs: -1}, false},
+			{"KickBan", RoomSettings{KickBanMs: 60000}, true},
+			{"KickBanTooLong", RoomSettings{KickBanMs: MaxKickBan.Milliseconds() + 1}, false},
+			{"TurnControl", RoomSettings{TurnControl: TurnControlCurrentPlayerOrHost}, true},
+			{"UnknownTurnControl", RoomSettings{TurnControl: "nobody"}, false},
+			{"Capacity", RoomSettings{Capacity: 4}, true},
+			{"CapacityTooSmall", RoomSettings{Capacity: 1}, false},
+			{"CapacityTooLarge", RoomSettings{Capacity: MaxRoomCapacity + 1}, false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.settings.Validate()
+				if (err == nil) != tt.valid {
+					t.Errorf("Validate() = %v, want valid=%v", err, tt.valid)
+				}
+			})
+		}
+	})
+
+	t.Run("SetSettingsAppliesDefaults", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		if err := room.SetSettings(RoomSettings{TurnTimeLimitMs: 1000}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if room.GetSettings().TurnExpiryPolicy != TurnExpiryFlag {
+			t.Errorf("Expected default policy %s, got %s", TurnExpiryFlag, room.GetSettings().TurnExpiryPolicy)
+		}
+	})
+
+	t.Run("SetSettingsRejectsInvalid", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		if err := room.SetSettings(RoomSettings{TurnTimeLimitMs: -5}); err == nil {
+			t.Error("Expected error for invalid settings")
+		}
+		if room.GetSettings().TurnTimeLimitMs != 0 {
+			t.Error("Expected settings to be unchanged")
+		}
+	})
+
+	t.Run("UpdateSettingsKeepsOtherFields", func(t *testing.T) {
+		room := NewRoom("TEST123")
+		room.SetSettings(RoomSettings{Capacity: 4, PauseHostOnly: true, UndoDepth: 2})
+		room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+
+		limit := int64(30000)
+		settings, err := room.UpdateSettings(RoomSettingsPatch{TurnTimeLimitMs: &limit})
+		if err != nil {
+			t.Fatalf("Expected a partial update to leave the capacity alone: %v", err)
+		}
+		if settings.TurnTimeLimitMs != limit || settings.Capacity != 4 || !settings.PauseHostOnly || settings.UndoDepth != 2 {
+			t.Errorf("Expected only the turn time limit to change, got %+v", settings)
+		}
+
+		capacity := 6
+		if _, err := room.UpdateSettings(RoomSettingsPatch{Capacity: &capacity}); err == nil {
+			t.Error("Expected a capacity change in an occupied room to fail")
+		}
+	})
+}
+
+// setupTimedRoom creates a hub and a two-player room with the given settings and an active turn for client1
+func setupTimedRoom(t *testing.T, settings RoomSettings) (*Hub, *Room) {
+	t.Helper()
+	hub := NewHub()
+	room := NewRoom("TEST123")
+	room.AddClient(createTestClient("client1", "Alice", "#FF0000"))
+	room.AddClient(createTestClient("client2", "Bob", "#00FF00"))
+	if err := room.SetSettings(settings); err != nil {
+		t.Fatalf("Invalid settings: %v", err)
+	}
+	hub.AddRoom(room.ID, room)
+	room.SetCurrentTurn("", "client1", "")
+	return hub, room
+}
+
+func TestTurnTimers(t *testing.T) {
+	t.Run("WarningThenExpiry", func(t *testing.T) {
+		hub, room := setupTimedRoom(t, RoomSettings{TurnTimeLimitMs: 150, TurnWarningMs: 100})
+		defer hub.Shutdown()
+
+		events := make(chan string, 2)
+		hub.OnTurnWarning = func(roomID, clientID string, remainingMs int64) {
+			if remainingMs > 100 {
+				t.Errorf("Expected at most 100ms remaining, got %d", remainingMs)
+			}
+			events <- "warning:" + clientID
+		}
+		hub.OnTurnExpired = func(roomID, clientID, policy string) {
+			events <- "expired:" + clientID + ":" + policy
+		}
+
+		hub.ScheduleTurnTimers(room)
+
+		for _, want := range []string{"warning:client1", "expired:client1:flag"} {
+			select {
+			case got := <-events:
+				if got != want {
+					t.Errorf("Expected %s, got %s", want, got)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("Timed out waiting for %s", want)
+			}
+		}
+
+		// Flag policy leaves the turn running
+		if room.GetCurrentTurn() != "client1" {
+			t.Errorf("Expected turn to stay with client1, got %s", room.GetCurrentTurn())
+		}
+	})
+
+	t.Run("EndPolicyClearsTurn", func(t *testing.T) {
+		hub, room := setupTimedRoom(t, RoomSettings{TurnTimeLimitMs: 50, TurnExpiryPolicy: TurnExpiryEnd})
+		defer hub.Shutdown()
+
+		expired := make(chan struct{}, 1)
+		hub.OnTurnExpired = func(roomID, clientID, policy string) { expired <- struct{}{} }
+		hub.ScheduleTurnTimers(room)
+
+		select {
+		case <-expired:
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for expiry")
+		}
+		if room.GetCurrentTurn() != "" {
+			t.Errorf("Expected no active turn, got %s", room.GetCurrentTurn())
+		}
+		if room.Clients["client1"].TotalTurnTime < 50 {
+			t.Errorf("Expected expired turn time to be credited, got %d", room.Clients["client1"].TotalTurnTime)
+		}
+	})
+
+	t.Run("AdvancePolicyMovesToNextSeat", func(t *testing.T) {
+		hub, room := setupTimedRoom(t, RoomSettings{TurnTimeLimitMs: 50, TurnExpiryPolicy: TurnExpiryAdvance})
+		defer hub.Shutdown()
+
+		expired := make(chan struct{}, 1)
+		hub.OnTurnExpired = func(roomID, clientID, policy string) { expired <- struct{}{} }
+		hub.ScheduleTurnTimers(room)
+
+		select {
+		case <-expired:
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for expiry")
+		}
+		if room.GetCurrentTurn() != "client2" {
+			t.Errorf("Expected turn to advance to client2, got %s", room.GetCurrentTurn())
+		}
+	})
+
+	t.Run("StaleTimerIgnoredAfterTurnChange", func(t *testing.T) {
+		hub, room := setupTimedRoom(t, RoomSettings{TurnTimeLimitMs: 50, TurnExpiryPolicy: TurnExpiryEnd})
+		defer hub.Shutdown()
+
+		expired := make(chan string, 1)
+		hub.OnTurnExpired = func(roomID, clientID, policy string) { expired <- clientID }
+		hub.ScheduleTurnTimers(room)
+
+		// Change the turn without rescheduling - the armed timer now belongs to a stale turn
+		room.SetCurrentTurn("client1", "client2", "")
+
+		select {
+		case clientID := <-expired:
+			t.Errorf("Expected stale timer to be ignored, got expiry for %s", clientID)
+		case <-time.After(150 * time.Millisecond):
+		}
+		if room.GetCurrentTurn() != "client2" {
+			t.Errorf("Expected client2 to keep the turn, got %s", room.GetCurrentTurn())
+		}
+	})
+
+	t.Run("RescheduleReplacesTimers", func(t *testing.T) {
+		hub, room := setupTimedRoom(t, RoomSettings{TurnTimeLimitMs: 50})
+		defer hub.Shutdown()
+
+		expired := make(chan string, 2)
+		hub.OnTurnExpired = func(roomID, clientID, policy string) { expired <- clientID }
+		hub.ScheduleTurnTimers(room)
+		room.ClearCurrentTurn("")
+		hub.ScheduleTurnTimers(room)
+
+		select {
+		case clientID := <-expired:
+			t.Errorf("Expected no expiry after turn was cleared, got %s", clientID)
+		case <-time.After(150 * time.Millisecond):
+		}
+
+		hub.turnTimersMu.Lock()
+		armed := len(hub.turnTimers)
+		hub.turnTimersMu.Unlock()
+		if armed != 0 {
+			t.Errorf("Expected no armed timers, got %d", armed)
+		}
+	})
+
+	t.Run("NoLimitArmsNothing", func(t *testing.T) {
+		hub, room := setupTimedRoom(t, RoomSettings{})
+		defer hub.Shutdown()
+
+		hub.ScheduleTurnTimers(room)
+
+		hub.turnTimersMu.Lock()
+		armed := len(hub.turnTimers)
+		hub.turnTimersMu.Unlock()
+		if armed != 0 {
+			t.Errorf("Expected no armed timers, got %d", armed)
+		}
+	})
+}
@@ -27,6 +27,9 @@ func (h *Hub) handleUnregister(client *Client) {
 		h.UnregisterIP(client.IP)
 	}
 
+	// A client waiting for a seat gives up its place in line
+	h.LeaveWaitlist(client)
+
 	roomID := client.RoomID
 	clientID := client.ClientID
 
@@ -39,6 +42,8 @@ func (h *Hub) handleUnregister(client *Client) {
 			Color:          client.Color,
 			TotalTurnTime:  client.TotalTurnTime,
 			LastRoomID:     roomID,
+			Spectator:      client.Spectator,
+			ResumeToken:    client.ResumeToken,
 			DisconnectedAt: time.Now(),
 		}
 
@@ -61,6 +66,19 @@ func (h *Hub) handleUnregister(client *Client) {
 		return
 	}
 
+	room := h.GetRoom(roomID)
+	if room != nil && !room.HasConnection(client) {
+		// The client already reconnected - the room belongs to the new connection
+		log.Printf("Client unregistered: %s (ID: %s, replaced by a newer connection)", remoteAddr, clientID)
+		return
+	}
+
+	// A seated player keeps their seat as away for a grace period (see markAway)
+	if room != nil && h.markAway(room, client) {
+		log.Printf("Client unregistered: %s (ID: %s, away from room %s)", remoteAddr, clientID, roomID)
+		return
+	}
+
 	// Remove client from room using centralized helper
 	// This handles turn cleanup and notifications automatically
 	h.RemoveClientFromRoom(roomID, clientID, "disconnect")
@@ -202,7 +202,7 @@ func TestUnregister(t *testing.T) {
 		}
 	})
 
-	t.Run("RemovesClientFromRoom", func(t *testing.T) {
+	t.Run("KeepsDisconnectedPlayerSeatedAsAway", func(t *testing.T) {
 		hub := NewHub()
 		room := NewRoom("ROOM123")
 		client1 := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
@@ -215,85 +215,131 @@ func TestUnregister(t *testing.T) {
 		hub.rooms["ROOM123"] = room
 		hub.mu.Unlock()
 
+		var presence string
+		hub.OnPresenceChanged = func(roomID, clientID, p string) {
+			presence = p
+		}
+		playerLeftCalled := false
+		hub.OnPlayerLeft = func(roomID, clientID string, message []byte) {
+			playerLeftCalled = true
+		}
+
 		hub.clients[client1] = true
 		hub.handleUnregister(client1)
+		defer hub.stopAllAwayTimers()
 
 		room.mu.RLock()
 		_, exists := room.Clients[client1.ClientID]
 		client2Exists := room.Clients[client2.ClientID] != nil
 		room.mu.RUnlock()
 
-		if exists {
-			t.Error("Expected client1 to be removed from room")
+		if !exists {
+			t.Error("Expected client1 to keep their seat")
+		}
+		if !room.IsAway(client1.ClientID) {
+			t.Error("Expected client1 to be away")
 		}
 		if !client2Exists {
 			t.Error("Expected client2 to remain in room")
 		}
+		if presence != PresenceAway {
+			t.Errorf("Expected OnPresenceChanged with '%s', got '%s'", PresenceAway, presence)
+		}
+		if playerLeftCalled {
+			t.Error("Expected OnPlayerLeft NOT to be called for an away player")
+		}
 	})
 
-	t.Run("DoesNotDeleteEmptyRoom", func(t *testing.T) {
+	t.Run("RemovesDisconnectedSpectator", func(t *testing.T) {
 		hub := NewHub()
 		room := NewRoom("ROOM123")
-		client := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+		player := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+		spectator := createTestClient("client-2", "ROOM123", "Bob", "#00FF00")
+		spectator.Spectator = true
 
-		room.Clients[client.ClientID] = client
+		room.Clients[player.ClientID] = player
+		room.AddSpectator(spectator)
 
 		hub.mu.Lock()
 		hub.rooms["ROOM123"] = room
 		hub.mu.Unlock()
 
-		hub.clients[client] = true
-		hub.handleUnregister(client)
-
-		hub.mu.RLock()
-		_, exists := hub.rooms["ROOM123"]
-		hub.mu.RUnlock()
+		hub.clients[spectator] = true
+		hub.handleUnregister(spectator)
 
-		if !exists {
-			t.Error("Expected empty room to NOT be deleted (scheduled cleanup will handle it)")
+		if room.HasConnection(spectator) {
+			t.Error("Expected spectator to be removed from room")
 		}
 	})
 
-	t.Run("DoesNotCallCallbacksWhenRoomBecomesEmpty", func(t *testing.T) {
+	t.Run("IgnoresStaleConnection", func(t *testing.T) {
 		hub := NewHub()
 		room := NewRoom("ROOM123")
-		client := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+		stale := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+		current := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
 
-		room.Clients[client.ClientID] = client
-		room.CurrentTurn = "client-1"
-		now := time.Now().UnixNano()
-		room.TurnStartTime = &now
+		room.Clients[current.ClientID] = current
 
 		hub.mu.Lock()
 		hub.rooms["ROOM123"] = room
 		hub.mu.Unlock()
 
-		hub.clients[client] = true
+		hub.clients[stale] = true
+		hub.handleUnregister(stale)
 
-		turnEndedCalled := false
-		playerLeftCalled := false
-		hub.OnTurnEnded = func(roomID string) {
-			turnEndedCalled = true
+		if room.IsAway(current.ClientID) {
+			t.Error("Expected the newer connection to stay connected")
 		}
-		hub.OnPlayerLeft = func(roomID, clientID string, message []byte) {
-			playerLeftCalled = true
+		if !room.HasConnection(current) {
+			t.Error("Expected the newer connection to keep the seat")
 		}
+	})
 
-		hub.handleUnregister(client)
+	t.Run("RemovesAwayPlayerAfterGracePeriod", func(t *testing.T) {
+		hub := NewHub()
+		hub.SetAwayGracePeriods(time.Hour, 20*time.Millisecond)
+		room := NewRoom("ROOM123")
+		client1 := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+		client2 := createTestClient("client-2", "ROOM123", "Bob", "#00FF00")
 
-		// Room is now empty, but OnTurnEnded SHOULD be called if client had turn
-		// (This ensures turn_changed message is sent even when room becomes empty)
-		// OnPlayerLeft should NOT be called when room becomes empty
-		if !turnEndedCalled {
-			t.Error("Expected OnTurnEnded to be called when client with turn leaves (even if room becomes empty)")
+		room.Clients[client1.ClientID] = client1
+		room.Clients[client2.ClientID] = client2
+		room.host = client1.ClientID
+
+		hub.mu.Lock()
+		hub.rooms["ROOM123"] = room
+		hub.mu.Unlock()
+
+		gone := make(chan string, 1)
+		hub.OnPresenceChanged = func(roomID, clientID, presence string) {
+			if presence == PresenceGone {
+				gone <- clientID
+			}
 		}
-		if playerLeftCalled {
-			t.Error("Expected OnPlayerLeft NOT to be called when room becomes empty")
+
+		hub.clients[client1] = true
+		hub.handleUnregister(client1)
+
+		select {
+		case clientID := <-gone:
+			if clientID != "client-1" {
+				t.Errorf("Expected client-1 to be gone, got '%s'", clientID)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected OnPresenceChanged with 'gone' after the grace period")
+		}
+
+		if room.HasConnection(client1) {
+			t.Error("Expected client1 to be removed from room")
+		}
+		if host := room.GetHost(); host != "client-2" {
+			t.Errorf("Expected host to pass to client-2, got '%s'", host)
 		}
 	})
 
-	t.Run("CallsOnTurnEndedWhenClientHadTurnAndRoomNotEmpty", func(t *testing.T) {
+	t.Run("ReconnectStopsGracePeriods", func(t *testing.T) {
 		hub := NewHub()
+		hub.SetAwayGracePeriods(20*time.Millisecond, 20*time.Millisecond)
 		room := NewRoom("ROOM123")
 		client1 := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
 		client2 := createTestClient("client-2", "ROOM123", "Bob", "#00FF00")
@@ -309,32 +355,99 @@ func TestUnregister(t *testing.T) {
 		hub.mu.Unlock()
 
 		hub.clients[client1] = true
+		hub.handleUnregister(client1)
 
-		turnEndedCalled := false
-		var calledRoomID string
+		returned := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+		if !hub.Reconnect(room, returned) {
+			t.Fatal("Expected away player to reconnect")
+		}
+		time.Sleep(60 * time.Millisecond)
+
+		if !room.HasConnection(returned) || room.IsAway("client-1") {
+			t.Error("Expected the returned connection to hold the seat")
+		}
+		if turn := room.GetCurrentTurn(); turn != "client-1" {
+			t.Errorf("Expected client-1 to keep the turn, got '%s'", turn)
+		}
+	})
+
+	t.Run("DoesNotDeleteEmptyRoom", func(t *testing.T) {
+		hub := NewHub()
+		room := NewRoom("ROOM123")
+		client := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+
+		room.Clients[client.ClientID] = client
+
+		hub.mu.Lock()
+		hub.rooms["ROOM123"] = room
+		hub.mu.Unlock()
+
+		hub.clients[client] = true
+		hub.handleUnregister(client)
+
+		hub.mu.RLock()
+		_, exists := hub.rooms["ROOM123"]
+		hub.mu.RUnlock()
+
+		if !exists {
+			t.Error("Expected empty room to NOT be deleted (scheduled cleanup will handle it)")
+		}
+	})
+
+	t.Run("DoesNotCallCallbacksWhenRoomBecomesEmpty", func(t *testing.T) {
+		hub := NewHub()
+		hub.SetAwayGracePeriods(time.Hour, 20*time.Millisecond)
+		room := NewRoom("ROOM123")
+		client := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
+
+		room.Clients[client.ClientID] = client
+		room.CurrentTurn = "client-1"
+		now := time.Now().UnixNano()
+		room.TurnStartTime = &now
+
+		hub.mu.Lock()
+		hub.rooms["ROOM123"] = room
+		hub.mu.Unlock()
+
+		hub.clients[client] = true
+
+		turnEnded := make(chan string, 1)
+		var presenceCalls atomic.Int32
 		hub.OnTurnEnded = func(roomID string) {
-			turnEndedCalled = true
-			calledRoomID = roomID
+			turnEnded <- roomID
+		}
+		hub.OnPresenceChanged = func(roomID, clientID, presence string) {
+			presenceCalls.Add(1)
 		}
 
-		hub.handleUnregister(client1)
+		hub.handleUnregister(client)
 
-		if !turnEndedCalled {
-			t.Error("Expected OnTurnEnded callback to be called when client had turn and room is not empty")
+		// Room is now empty once the grace period ends, but OnTurnEnded SHOULD be called if client had turn
+		// (This ensures turn_changed message is sent even when room becomes empty)
+		// Only the away notice is sent - nobody is left to hear that the player is gone
+		select {
+		case <-turnEnded:
+		case <-time.After(time.Second):
+			t.Fatal("Expected OnTurnEnded to be called when client with turn leaves (even if room becomes empty)")
 		}
-		if calledRoomID != "ROOM123" {
-			t.Errorf("Expected OnTurnEnded to be called with 'ROOM123', got '%s'", calledRoomID)
+		if calls := presenceCalls.Load(); calls != 1 {
+			t.Errorf("Expected only the away OnPresenceChanged call when room becomes empty, got %d calls", calls)
 		}
 	})
 
-	t.Run("CallsOnPlayerLeftWhenRoomNotEmpty", func(t *testing.T) {
+	t.Run("CallsOnTurnEndedWhenAwayTurnGraceEnds", func(t *testing.T) {
 		hub := NewHub()
+		hub.SetAwayGracePeriods(20*time.Millisecond, time.Hour)
+		defer hub.stopAllAwayTimers()
 		room := NewRoom("ROOM123")
 		client1 := createTestClient("client-1", "ROOM123", "Alice", "#FF0000")
 		client2 := createTestClient("client-2", "ROOM123", "Bob", "#00FF00")
 
 		room.Clients[client1.ClientID] = client1
 		room.Clients[client2.ClientID] = client2
+		room.CurrentTurn = "client-1"
+		now := time.Now().UnixNano()
+		room.TurnStartTime = &now
 
 		hub.mu.Lock()
 		hub.rooms["ROOM123"] = room
@@ -342,24 +455,26 @@ func TestUnregister(t *testing.T) {
 
 		hub.clients[client1] = true
 
-		playerLeftCalled := false
-		var calledRoomID, calledClientID string
-		hub.OnPlayerLeft = func(roomID, clientID string, message []byte) {
-			playerLeftCalled = true
-			calledRoomID = roomID
-			calledClientID = clientID
+		turnEnded := make(chan string, 1)
+		hub.OnTurnEnded = func(roomID string) {
+			turnEnded <- roomID
 		}
 
 		hub.handleUnregister(client1)
 
-		if !playerLeftCalled {
-			t.Error("Expected OnPlayerLeft callback to be called when room is not empty")
+		select {
+		case calledRoomID := <-turnEnded:
+			if calledRoomID != "ROOM123" {
+				t.Errorf("Expected OnTurnEnded to be called with 'ROOM123', got '%s'", calledRoomID)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected OnTurnEnded callback to be called when the away player's turn grace ends")
 		}
-		if calledRoomID != "ROOM123" {
-			t.Errorf("Expected OnPlayerLeft to be called with roomID 'ROOM123', got '%s'", calledRoomID)
+		if turn := room.GetCurrentTurn(); turn != "" {
+			t.Errorf("Expected turn to end, got '%s'", turn)
 		}
-		if calledClientID != "client-1" {
-			t.Errorf("Expected OnPlayerLeft to be called with clientID 'client-1', got '%s'", calledClientID)
+		if !room.IsAway("client-1") {
+			t.Error("Expected client-1 to stay seated as away")
 		}
 	})
 
@@ -0,0 +1,116 @@
+package counters
+
+import (
+	"log"
+	"strings"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/helpers"
+	"turn-tracker/backend/types"
+)
+
+// HandleDefineCounter handles adding a counter to the room, or changing an existing counter's bounds and default
+func HandleDefineCounter(hub *core.Hub, client *core.Client, def core.CounterDef) {
+	room := countersRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	def.Name = strings.TrimSpace(def.Name)
+	if !helpers.IsValidDisplayName(def.Name) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid counter name")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	if err := room.DefineCounter(def); err != nil {
+		client.SendRoomError(err, types.ErrorCodeInvalidData)
+		return
+	}
+
+	broadcastCountersChanged(hub, room, client)
+	log.Printf("Counter %q defined in room %s by client %s", def.Name, room.ID, client.ClientID)
+}
+
+// HandleRemoveCounter handles deleting a counter and every player's value for it
+func HandleRemoveCounter(hub *core.Hub, client *core.Client, name string) {
+	room := countersRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	if err := room.RemoveCounter(name); err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	broadcastCountersChanged(hub, room, client)
+	log.Printf("Counter %q removed from room %s by client %s", name, room.ID, client.ClientID)
+}
+
+// HandleAdjustCounter handles adding delta to a player's counter
+// If clientID is empty, the sender's counter changes
+func HandleAdjustCounter(hub *core.Hub, client *core.Client, clientID, name string, delta int64) {
+	changeCounter(hub, client, clientID, name, delta, true)
+}
+
+// HandleSetCounter handles setting a player's counter
+// If clientID is empty, the sender's counter changes
+func HandleSetCounter(hub *core.Hub, client *core.Client, clientID, name string, value int64) {
+	changeCounter(hub, client, clientID, name, value, false)
+}
+
+// changeCounter sets or adjusts a counter and tells everyone in the room
+func changeCounter(hub *core.Hub, client *core.Client, clientID, name string, value int64, delta bool) {
+	room := countersRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	if clientID == "" {
+		clientID = client.ClientID
+	}
+	newValue, err := room.ChangeCounter(clientID, name, value, delta)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	counterChangedMsg, err := NewCounterChangedMessage(room.ID, clientID, name, newValue, client.ClientID)
+	if err != nil {
+		log.Printf("Error creating counter_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(counterChangedMsg))
+	log.Printf("Counter %q of client %s in room %s set to %d by client %s", name, clientID, room.ID, newValue, client.ClientID)
+}
+
+// countersRoom returns the room whose counters the client wants to change
+// Sends an error to the client and returns nil if the counters cannot be changed
+func countersRoom(hub *core.Hub, client *core.Client) *core.Room {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	return room
+}
+
+// broadcastCountersChanged sends every counter and every player's values to everyone in the room
+func broadcastCountersChanged(hub *core.Hub, room *core.Room, client *core.Client) {
+	countersChangedMsg, err := NewCountersChangedMessage(room.ID, room.ListCounters(), room.ListPeerInfo(), client.ClientID)
+	if err != nil {
+		log.Printf("Error creating counters_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(countersChangedMsg))
+}
@@ -0,0 +1,167 @@
+package counters
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "define_counter":
+			var data DefineCounterData
+			json.Unmarshal(msg.Data, &data)
+			HandleDefineCounter(hub, client, core.CounterDef{Name: data.Name, Min: data.Min, Max: data.Max, Default: data.Default})
+		case "remove_counter":
+			var data RemoveCounterData
+			json.Unmarshal(msg.Data, &data)
+			HandleRemoveCounter(hub, client, data.Name)
+		case "adjust_counter":
+			var data AdjustCounterData
+			json.Unmarshal(msg.Data, &data)
+			HandleAdjustCounter(hub, client, data.ClientID, data.Name, data.Delta)
+		case "set_counter":
+			var data SetCounterData
+			json.Unmarshal(msg.Data, &data)
+			HandleSetCounter(hub, client, data.ClientID, data.Name, data.Value)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// defineLife defines a life counter from 0 to 20 and waits for the broadcast
+func defineLife(t *testing.T, client *test_helpers.TestWebSocketClient) CountersChangedData {
+	t.Helper()
+	client.SendMessage("define_counter", map[string]interface{}{"name": "Life", "min": 0, "max": 20, "default": 20})
+	resp, err := client.ReceiveMessageOfType("counters_changed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive counters_changed: %v", err)
+	}
+	var data CountersChangedData
+	json.Unmarshal(resp.Data, &data)
+	return data
+}
+
+// receiveCounterChanged waits for a counter_changed message
+func receiveCounterChanged(t *testing.T, client *test_helpers.TestWebSocketClient) CounterChangedData {
+	t.Helper()
+	resp, err := client.ReceiveMessageOfType("counter_changed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive counter_changed: %v", err)
+	}
+	var data CounterChangedData
+	json.Unmarshal(resp.Data, &data)
+	return data
+}
+
+// TestCounters wraps all counter tests
+// This allows running all tests together or individually in the IDE
+func TestCounters(t *testing.T) {
+	t.Run("DefineCounterBroadcastsValues", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player := room.Clients[0], room.Clients[1]
+
+		changed := defineLife(t, host)
+		if len(changed.Counters) != 1 || changed.Counters[0].Name != "Life" || *changed.Counters[0].Max != 20 {
+			t.Errorf("Unexpected counters: %+v", changed.Counters)
+		}
+		if len(changed.Peers) != 2 || changed.Peers[0].Counters["Life"] != 20 || changed.Peers[1].Counters["Life"] != 20 {
+			t.Errorf("Expected every player at the default, got %+v", changed.Peers)
+		}
+
+		host.SendMessage("remove_counter", map[string]interface{}{"name": "Life"})
+		resp, err := player.ReceiveMessageOfType("counters_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive counters_changed: %v", err)
+		}
+		resp, err = player.ReceiveMessageOfType("counters_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive counters_changed after remove_counter: %v", err)
+		}
+		var removed CountersChangedData
+		json.Unmarshal(resp.Data, &removed)
+		if removed.Counters == nil || len(removed.Counters) != 0 {
+			t.Errorf("Expected an empty counter list, got %+v", removed.Counters)
+		}
+	})
+
+	t.Run("AdjustAndSetCounter", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player, playerID := room.Clients[0], room.Clients[1], room.ClientIDs[1]
+		defineLife(t, host)
+
+		host.SendMessage("adjust_counter", map[string]interface{}{"client_id": playerID, "name": "Life", "delta": -4})
+		changed := receiveCounterChanged(t, player)
+		if changed.ClientID != playerID || changed.Name != "Life" || changed.Value != 16 {
+			t.Errorf("Unexpected counter_changed: %+v", changed)
+		}
+
+		player.SendMessage("set_counter", map[string]interface{}{"name": "Life", "value": 9})
+		changed = receiveCounterChanged(t, host)
+		changed = receiveCounterChanged(t, host)
+		if changed.ClientID != playerID || changed.Value != 9 || changed.ChangedBy != playerID {
+			t.Errorf("Expected the player's own counter set to 9, got %+v", changed)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host := room.Clients[0]
+		defineLife(t, host)
+
+		host.SendMessage("adjust_counter", map[string]interface{}{"name": "Life", "delta": 1})
+		if msg := test_helpers.ReceiveError(t, host).Message; msg != "Counter out of range" {
+			t.Errorf("Expected out of range error, got '%s'", msg)
+		}
+
+		host.SendMessage("set_counter", map[string]interface{}{"name": "Mana", "value": 1})
+		if msg := test_helpers.ReceiveError(t, host).Message; msg != "Counter not found" {
+			t.Errorf("Expected counter not found, got '%s'", msg)
+		}
+
+		host.SendMessage("define_counter", map[string]interface{}{"name": "  "})
+		if msg := test_helpers.ReceiveError(t, host).Message; msg != "Invalid counter name" {
+			t.Errorf("Expected invalid name error, got '%s'", msg)
+		}
+
+		host.SendMessage("define_counter", map[string]interface{}{"name": "Poison", "min": 5, "max": 1})
+		if msg := test_helpers.ReceiveError(t, host).Message; msg != "Counter min is above max" {
+			t.Errorf("Expected bounds error, got '%s'", msg)
+		}
+	})
+}
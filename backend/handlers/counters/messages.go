@@ -0,0 +1,61 @@
+package counters
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewCountersChangedMessage creates a counters_changed message
+func NewCountersChangedMessage(roomID string, counters []core.CounterDef, peers []core.PeerInfo, changedBy string) ([]byte, error) {
+	if counters == nil {
+		counters = []core.CounterDef{} // Send an empty list rather than null once the last counter is removed
+	}
+	data := CountersChangedData{
+		RoomID:    roomID,
+		Counters:  counters,
+		Peers:     peers,
+		ChangedBy: changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "counters_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewCounterChangedMessage creates a counter_changed message
+func NewCounterChangedMessage(roomID, clientID, name string, value int64, changedBy string) ([]byte, error) {
+	data := CounterChangedData{
+		RoomID:    roomID,
+		ClientID:  clientID,
+		Name:      name,
+		Value:     value,
+		ChangedBy: changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "counter_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,49 @@
+package counters
+
+import "turn-tracker/backend/core"
+
+// DefineCounterData is the data structure for define_counter messages
+// Defining a counter that already exists replaces its bounds and default
+type DefineCounterData struct {
+	Name    string `json:"name"`
+	Min     *int64 `json:"min,omitempty"` // Lowest allowed value (unbounded if omitted)
+	Max     *int64 `json:"max,omitempty"` // Highest allowed value (unbounded if omitted)
+	Default int64  `json:"default"`       // Value every player starts with
+}
+
+// RemoveCounterData is the data structure for remove_counter messages
+type RemoveCounterData struct {
+	Name string `json:"name"`
+}
+
+// AdjustCounterData is the data structure for adjust_counter messages
+type AdjustCounterData struct {
+	ClientID string `json:"client_id,omitempty"` // Player whose counter changes (defaults to the sender)
+	Name     string `json:"name"`
+	Delta    int64  `json:"delta"`
+}
+
+// SetCounterData is the data structure for set_counter messages
+type SetCounterData struct {
+	ClientID string `json:"client_id,omitempty"` // Player whose counter changes (defaults to the sender)
+	Name     string `json:"name"`
+	Value    int64  `json:"value"`
+}
+
+// CountersChangedData is the data structure for counters_changed messages
+// Sent when a counter is defined or removed, with every player's values
+type CountersChangedData struct {
+	RoomID    string            `json:"room_id"`
+	Counters  []core.CounterDef `json:"counters"` // Every counter in definition order
+	Peers     []core.PeerInfo   `json:"peers"`    // Players with their counter values
+	ChangedBy string            `json:"changed_by"`
+}
+
+// CounterChangedData is the data structure for counter_changed messages
+type CounterChangedData struct {
+	RoomID    string `json:"room_id"`
+	ClientID  string `json:"client_id"` // Player whose counter changed
+	Name      string `json:"name"`
+	Value     int64  `json:"value"`      // New value
+	ChangedBy string `json:"changed_by"` // Client ID that made the change
+}
@@ -9,10 +9,23 @@ import (
 
 // HandleCreateRoom handles explicit room creation
 // If roomID is empty, generates a new game ID
-func HandleCreateRoom(hub *core.Hub, client *core.Client, roomID, displayName, color string) {
+// If pin is set, newcomers must enter it to join
+func HandleCreateRoom(hub *core.Hub, client *core.Client, roomID, displayName, color string, settings core.RoomSettings, pin string) {
 	// Initialize client profile (generates random if not provided)
 	core.InitializeClientProfile(client, displayName, color)
 
+	// Validate settings before claiming a room ID
+	if err := settings.Validate(); err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, err.Error())
+		client.SafeSend(errorMsg)
+		return
+	}
+	if err := core.ValidatePIN(pin); err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, err.Error())
+		client.SafeSend(errorMsg)
+		return
+	}
+
 	// Generate game ID if not provided or invalid
 	if roomID == "" || !helpers.IsValidGameID(roomID) {
 		// Generate unique game ID (with collision checking)
@@ -27,30 +40,34 @@ func HandleCreateRoom(hub *core.Hub, client *core.Client, roomID, displayName, c
 	} else {
 		// Check if room already exists when ID is provided
 		if hub.RoomExists(roomID) {
-			errorMsg, _ := types.NewErrorMessage("Room already exists")
+			errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRejected, "Room already exists")
 			client.SafeSend(errorMsg)
 			return
 		}
 	}
 
+	// A client waiting for a seat elsewhere gives up its place in line
+	hub.LeaveWaitlist(client)
+
 	// Create room
 	room := core.NewRoom(roomID)
 	room.CreatedBy = client.ClientID
-	room.Clients[client.ClientID] = client
+	room.SetSettings(settings)
+	room.AddClient(client)
+	room.SetPIN(pin) // After AddClient, so the creator is never asked for it
 	hub.AddRoom(roomID, room)
 
 	// Update client's room ID
 	client.RoomID = roomID
+	client.Spectator = false
 
-	// Send room_created message with peer info and current turn (if any)
-	peers := room.ListPeerInfo()
-	currentTurn := room.GetCurrentTurnInfo()
-	response, err := NewRoomCreatedMessage(roomID, client.ClientID, peers, currentTurn)
+	// Send room_created message with a snapshot of the room
+	response, err := NewRoomCreatedMessage(room, client)
 	if err != nil {
 		log.Printf("Error creating room_created message: %v", err)
 		return
 	}
-	client.SafeSend(response)
+	client.SafeSend(client.WithRequestID(response))
 
 	log.Printf("Room created: %s by client %s (%s)", roomID, client.ClientID, client.DisplayName)
 }
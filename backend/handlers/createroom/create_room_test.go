@@ -20,7 +20,7 @@ func setupTestMessageRouter() core.MessageHandler {
 		case "create_room":
 			var data CreateRoomData
 			json.Unmarshal(msg.Data, &data)
-			HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+			HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 		case "join_room":
 			var data joinroom.JoinRoomData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -29,7 +29,7 @@ func setupTestMessageRouter() core.MessageHandler {
 				return
 			}
 			roomID := strings.ToUpper(data.RoomID)
-			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
 		case "update_profile":
 			var data updateprofile.UpdateProfileData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -154,3 +154,92 @@ func TestCreateRoomWithoutProfile(t *testing.T) {
 		t.Error("Color should be generated by server")
 	}
 }
+
+func TestCreateRoomSettings(t *testing.T) {
+	t.Run("SettingsInSnapshot", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{
+				"turn_time_limit_ms": 60000,
+				"turn_warning_ms":    10000,
+				"turn_expiry_policy": "advance",
+			},
+		})
+
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		if resp.Type != "room_created" {
+			t.Fatalf("Expected 'room_created', got '%s'", resp.Type)
+		}
+
+		var data RoomCreatedData
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			t.Fatalf("Failed to unmarshal room_created: %v", err)
+		}
+		if data.Settings.TurnTimeLimitMs != 60000 || data.Settings.TurnWarningMs != 10000 {
+			t.Errorf("Expected time limit settings in snapshot, got %+v", data.Settings)
+		}
+		if data.Settings.TurnExpiryPolicy != "advance" {
+			t.Errorf("Expected policy 'advance', got '%s'", data.Settings.TurnExpiryPolicy)
+		}
+	})
+
+	t.Run("DefaultPolicy", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("create_room", map[string]interface{}{})
+		resp, _ := client.ReceiveMessage(5 * time.Second)
+
+		var data RoomCreatedData
+		json.Unmarshal(resp.Data, &data)
+		if data.Settings.TurnExpiryPolicy != "flag" {
+			t.Errorf("Expected default policy 'flag', got '%s'", data.Settings.TurnExpiryPolicy)
+		}
+	})
+
+	t.Run("InvalidSettingsRejected", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{
+				"turn_time_limit_ms": 1000,
+				"turn_warning_ms":    5000,
+			},
+		})
+
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		if resp.Type != "error" {
+			t.Errorf("Expected 'error', got '%s'", resp.Type)
+		}
+	})
+}
@@ -6,19 +6,12 @@ import (
 	"turn-tracker/backend/types"
 )
 
-// NewRoomCreatedMessage creates a room_created message
-func NewRoomCreatedMessage(roomID, yourClientID string, peers []core.PeerInfo, currentTurn core.PeerInfo) ([]byte, error) {
-	var currentTurnPtr *core.PeerInfo
-	// If currentTurn is not empty (has a ClientID), use it; otherwise set to nil for null in JSON
-	if currentTurn.ClientID != "" {
-		currentTurnPtr = &currentTurn
-	}
-
+// NewRoomCreatedMessage creates a room_created message with a snapshot of the room
+func NewRoomCreatedMessage(room *core.Room, client *core.Client) ([]byte, error) {
 	data := RoomCreatedData{
-		RoomID:       roomID,
-		YourClientID: yourClientID,
-		Peers:        peers,
-		CurrentTurn:  currentTurnPtr,
+		YourClientID: client.ClientID,
+		ResumeToken:  client.ResumeToken,
+		RoomSnapshot: room.Snapshot(),
 	}
 	dataJSON, err := json.Marshal(data)
 	if err != nil {
@@ -5,15 +5,16 @@ import "turn-tracker/backend/core"
 // CreateRoomData is the data structure for create_room messages
 // RoomID is optional - if not provided or empty, backend will generate one
 type CreateRoomData struct {
-	RoomID      string `json:"room_id,omitempty"`
-	DisplayName string `json:"display_name,omitempty"`
-	Color       string `json:"color,omitempty"`
+	RoomID      string            `json:"room_id,omitempty"`
+	DisplayName string            `json:"display_name,omitempty"`
+	Color       string            `json:"color,omitempty"`
+	Settings    core.RoomSettings `json:"settings,omitempty"` // Optional game configuration (defaults if omitted)
+	PIN         string            `json:"pin,omitempty"`      // Optional PIN newcomers must enter to join (4 to 8 digits)
 }
 
 // RoomCreatedData is the response data structure for room_created messages
 type RoomCreatedData struct {
-	RoomID      string          `json:"room_id"`
-	YourClientID string         `json:"your_client_id"` // Client ID of the message recipient
-	Peers       []core.PeerInfo `json:"peers"`
-	CurrentTurn *core.PeerInfo  `json:"current_turn,omitempty"` // nil if no turn active
+	YourClientID string `json:"your_client_id"` // Client ID of the message recipient
+	ResumeToken  string `json:"resume_token"`   // Secret the recipient presents (with its client ID) to reconnect
+	core.RoomSnapshot
 }
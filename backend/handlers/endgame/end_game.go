@@ -0,0 +1,50 @@
+package endgame
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandleEndGame ends the game in the client's room and broadcasts the summary
+// The room stays open so players can read the summary, but turns can no longer change
+// Ending the game cannot be undone, so the message router only lets the host through
+func HandleEndGame(hub *core.Hub, client *core.Client) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	summary, cutShort, err := room.EndGame(client.ClientID)
+	if err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeGameEnded, err.Error())
+		client.SafeSend(errorMsg)
+		return
+	}
+	hub.StopTurnTimers(room.ID)
+
+	// Announce that the active turn ended before the summary that counts it
+	if cutShort {
+		startturn.BroadcastTurnChanged(hub, room, client)
+	}
+
+	gameEndedMsg, err := NewGameEndedMessage(room.ID, summary)
+	if err != nil {
+		log.Printf("Error creating game_ended message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(gameEndedMsg))
+
+	log.Printf("Game ended in room %s by client %s after %dms", room.ID, client.ClientID, summary.SessionMs)
+}
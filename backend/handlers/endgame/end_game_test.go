@@ -0,0 +1,160 @@
+package endgame
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "start_turn":
+			var data startturn.StartTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid start_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "end_game":
+			HandleEndGame(hub, client)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// TestEndGame wraps all end_game tests
+// This allows running all tests together or individually in the IDE
+func TestEndGame(t *testing.T) {
+	t.Run("BroadcastsSummary", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		for _, c := range clients {
+			c.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		}
+
+		clients[0].SendMessage("end_game", nil)
+		for _, c := range clients {
+			turnResp, err := c.ReceiveMessageOfType("turn_changed", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive turn_changed: %v", err)
+			}
+			var turnData startturn.TurnChangedData
+			json.Unmarshal(turnResp.Data, &turnData)
+			if turnData.CurrentTurn != nil {
+				t.Errorf("Expected the active turn to end, got %+v", turnData.CurrentTurn)
+			}
+
+			resp, err := c.ReceiveMessageOfType("game_ended", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive game_ended: %v", err)
+			}
+			var data GameEndedData
+			json.Unmarshal(resp.Data, &data)
+			if data.EndedBy != clientIDs[0] || len(data.Players) != 2 {
+				t.Fatalf("Unexpected summary: %+v", data)
+			}
+			if host := data.Players[0]; host.ClientID != clientIDs[0] || host.DisplayName == "" || host.Turns != 1 {
+				t.Errorf("Expected the host's turn to be counted, got %+v", host)
+			}
+		}
+	})
+
+	t.Run("RoomIsFrozen", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("end_game", nil)
+		clients[0].ReceiveMessageOfType("game_ended", 5*time.Second)
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[1]})
+		if msg := test_helpers.ReceiveError(t, clients[0]).Message; msg != "Game has ended" {
+			t.Errorf("Expected 'Game has ended', got '%s'", msg)
+		}
+		clients[0].SendMessage("end_game", nil)
+		if msg := test_helpers.ReceiveError(t, clients[0]).Message; msg != "Game has already ended" {
+			t.Errorf("Expected 'Game has already ended', got '%s'", msg)
+		}
+	})
+
+	t.Run("SummaryInSnapshot", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients := room.Clients
+		clients[0].SendMessage("end_game", nil)
+		resp, _ := clients[0].ReceiveMessageOfType("game_ended", 5*time.Second)
+		var ended GameEndedData
+		json.Unmarshal(resp.Data, &ended)
+
+		late, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer late.Close()
+		time.Sleep(100 * time.Millisecond)
+		late.SendMessage("join_room", map[string]interface{}{"room_id": ended.RoomID})
+		resp, err = late.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joined joinroom.RoomJoinedData
+		json.Unmarshal(resp.Data, &joined)
+		if joined.Summary == nil || joined.Summary.EndedAt != ended.EndedAt {
+			t.Errorf("Expected the summary in the snapshot, got %+v", joined.Summary)
+		}
+	})
+
+	t.Run("NotInRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("end_game", nil)
+		if msg := test_helpers.ReceiveError(t, client).Message; msg != "Not in a room" {
+			t.Errorf("Expected 'Not in a room', got '%s'", msg)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package endgame
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewGameEndedMessage creates a game_ended message
+func NewGameEndedMessage(roomID string, summary core.GameSummary) ([]byte, error) {
+	data := GameEndedData{
+		RoomID:      roomID,
+		GameSummary: summary,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "game_ended",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,9 @@
+package endgame
+
+import "turn-tracker/backend/core"
+
+// GameEndedData is the data structure for game_ended messages
+type GameEndedData struct {
+	RoomID string `json:"room_id"`
+	core.GameSummary
+}
@@ -0,0 +1,38 @@
+package gethistory
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleGetHistory sends a page of the room's turn history to the requesting client
+func HandleGetHistory(hub *core.Hub, client *core.Client, offset, limit int) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	if offset < 0 || limit < 0 {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid history range")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	historyMsg, err := NewHistoryMessage(room.ID, room.GetHistory(offset, limit))
+	if err != nil {
+		log.Printf("Error creating history message: %v", err)
+		return
+	}
+	client.SafeSend(client.WithRequestID(historyMsg))
+}
@@ -0,0 +1,148 @@
+package gethistory
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "start_turn":
+			var data startturn.StartTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid start_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "get_history":
+			var data GetHistoryData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid get_history data")
+				client.Send <- errorMsg
+				return
+			}
+			HandleGetHistory(hub, client, data.Offset, data.Limit)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// playTurns plays the given number of turns, all by the client
+func playTurns(client *test_helpers.TestWebSocketClient, clientID string, turns int) {
+	for i := 0; i < turns; i++ {
+		client.SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientID})
+		client.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		client.SendMessage("start_turn", map[string]interface{}{"current_turn": clientID, "new_turn": ""})
+		client.ReceiveMessageOfType("turn_changed", 5*time.Second)
+	}
+}
+
+func receiveHistory(t *testing.T, client *test_helpers.TestWebSocketClient) HistoryData {
+	t.Helper()
+	resp, err := client.ReceiveMessageOfType("history", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive history: %v", err)
+	}
+	var data HistoryData
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("Failed to unmarshal history: %v", err)
+	}
+	return data
+}
+
+// TestGetHistory wraps all get_history tests
+// This allows running all tests together or individually in the IDE
+func TestGetHistory(t *testing.T) {
+	t.Run("ReturnsTurns", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, nil)
+		defer room.Close()
+		client, clientID := room.Clients[0], room.ClientIDs[0]
+		playTurns(client, clientID, 2)
+
+		client.SendMessage("get_history", map[string]interface{}{})
+		data := receiveHistory(t, client)
+		if data.Total != 2 || len(data.Turns) != 2 {
+			t.Fatalf("Expected 2 turns, got %+v", data)
+		}
+		for _, turn := range data.Turns {
+			if turn.ClientID != clientID || turn.EndedBy != clientID || turn.EndReason != "manual" {
+				t.Errorf("Unexpected turn: %+v", turn)
+			}
+		}
+	})
+
+	t.Run("Paging", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, nil)
+		defer room.Close()
+		client := room.Clients[0]
+		playTurns(client, room.ClientIDs[0], 3)
+
+		client.SendMessage("get_history", map[string]interface{}{"offset": 1, "limit": 1})
+		data := receiveHistory(t, client)
+		if data.Total != 3 || data.Offset != 1 || len(data.Turns) != 1 || data.Turns[0].Seq != 1 {
+			t.Errorf("Unexpected page: %+v", data)
+		}
+	})
+
+	t.Run("InvalidRange", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, nil)
+		defer room.Close()
+		client := room.Clients[0]
+
+		client.SendMessage("get_history", map[string]interface{}{"offset": -1})
+		resp, err := client.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "Invalid history range" {
+			t.Errorf("Expected 'Invalid history range', got '%s'", data.Message)
+		}
+	})
+
+	t.Run("NotInRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("get_history", map[string]interface{}{})
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		if resp.Type != "error" {
+			t.Errorf("Expected 'error', got '%s'", resp.Type)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package gethistory
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewHistoryMessage creates a history message
+func NewHistoryMessage(roomID string, page core.HistoryPage) ([]byte, error) {
+	data := HistoryData{
+		RoomID:      roomID,
+		HistoryPage: page,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "history",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
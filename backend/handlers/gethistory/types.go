@@ -0,0 +1,15 @@
+package gethistory
+
+import "turn-tracker/backend/core"
+
+// GetHistoryData is the data structure for get_history messages
+type GetHistoryData struct {
+	Offset int `json:"offset,omitempty"` // Index of the first turn to return (0 = oldest kept turn)
+	Limit  int `json:"limit,omitempty"`  // Maximum number of turns to return (0 = default page size)
+}
+
+// HistoryData is the data structure for history messages (sent only to the requesting client)
+type HistoryData struct {
+	RoomID string `json:"room_id"`
+	core.HistoryPage
+}
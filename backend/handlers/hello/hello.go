@@ -0,0 +1,27 @@
+package hello
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleHello agrees a protocol version and optional features with the client
+// Replies with welcome, after which messages to the client are shaped to what it understands
+// A client may send hello again (e.g. after an update) to change what it agreed to
+func HandleHello(hub *core.Hub, client *core.Client, version int, features []string) {
+	agreedVersion, enabled, err := client.Negotiate(version, features)
+	if err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeUnsupportedProtocol, err.Error())
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	welcomeMsg, err := NewWelcomeMessage(client, agreedVersion, enabled)
+	if err != nil {
+		log.Printf("Error creating welcome message: %v", err)
+		return
+	}
+	client.SafeSend(client.WithRequestID(welcomeMsg))
+	log.Printf("Client %s speaks protocol version %d with features %v", client.ClientID, agreedVersion, enabled)
+}
@@ -0,0 +1,113 @@
+package hello
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "hello":
+			var data HelloData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid hello data")
+				client.Send <- errorMsg
+				return
+			}
+			HandleHello(hub, client, data.ProtocolVersion, data.Features)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+func TestHello(t *testing.T) {
+	server := test_helpers.SetupTestServer(setupTestMessageRouter())
+	defer server.Cleanup()
+
+	connect := func(t *testing.T) *test_helpers.TestWebSocketClient {
+		t.Helper()
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+		return client
+	}
+
+	t.Run("AgreesSharedFeatures", func(t *testing.T) {
+		client := connect(t)
+		defer client.Close()
+
+		client.SendMessage("hello", map[string]interface{}{
+			"protocol_version": core.ProtocolVersion,
+			"features":         []string{core.FeaturePresence, "from_the_future"},
+		})
+		resp, err := client.ReceiveMessageOfType("welcome", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive welcome: %v", err)
+		}
+		var welcome WelcomeData
+		json.Unmarshal(resp.Data, &welcome)
+
+		if welcome.ProtocolVersion != core.ProtocolVersion || welcome.ServerVersion != core.ProtocolVersion {
+			t.Errorf("Expected protocol version %d, got %+v", core.ProtocolVersion, welcome)
+		}
+		if welcome.MinProtocolVersion != core.MinProtocolVersion {
+			t.Errorf("Expected min protocol version %d, got %d", core.MinProtocolVersion, welcome.MinProtocolVersion)
+		}
+		if len(welcome.Capabilities) != len(core.ServerFeatures) {
+			t.Errorf("Expected every server feature as a capability, got %v", welcome.Capabilities)
+		}
+		if len(welcome.Features) != 1 || welcome.Features[0] != core.FeaturePresence {
+			t.Errorf("Expected only the shared feature to be enabled, got %v", welcome.Features)
+		}
+		if welcome.YourClientID == "" || welcome.ResumeToken == "" {
+			t.Errorf("Expected the client ID and its resume token, got %+v", welcome)
+		}
+	})
+
+	t.Run("NewerClientGetsServerVersion", func(t *testing.T) {
+		client := connect(t)
+		defer client.Close()
+
+		client.SendMessage("hello", map[string]interface{}{"protocol_version": core.ProtocolVersion + 5})
+		resp, err := client.ReceiveMessageOfType("welcome", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive welcome: %v", err)
+		}
+		var welcome WelcomeData
+		json.Unmarshal(resp.Data, &welcome)
+
+		if welcome.ProtocolVersion != core.ProtocolVersion {
+			t.Errorf("Expected the server's version %d, got %d", core.ProtocolVersion, welcome.ProtocolVersion)
+		}
+		if welcome.Features == nil || len(welcome.Features) != 0 {
+			t.Errorf("Expected an empty feature list, got %v", welcome.Features)
+		}
+	})
+
+	t.Run("RejectsUnsupportedVersion", func(t *testing.T) {
+		client := connect(t)
+		defer client.Close()
+
+		client.SendMessage("hello", map[string]interface{}{"protocol_version": core.MinProtocolVersion - 1})
+		resp, err := client.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var errorData types.ErrorData
+		json.Unmarshal(resp.Data, &errorData)
+
+		if errorData.Code != types.ErrorCodeUnsupportedProtocol {
+			t.Errorf("Expected code %s, got %+v", types.ErrorCodeUnsupportedProtocol, errorData)
+		}
+	})
+}
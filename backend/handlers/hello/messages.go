@@ -0,0 +1,35 @@
+package hello
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewWelcomeMessage creates a welcome message
+func NewWelcomeMessage(client *core.Client, version int, features []string) ([]byte, error) {
+	data := WelcomeData{
+		ProtocolVersion:    version,
+		ServerVersion:      core.ProtocolVersion,
+		MinProtocolVersion: core.MinProtocolVersion,
+		Capabilities:       core.ServerFeatures,
+		Features:           features,
+		YourClientID:       client.ClientID,
+		ResumeToken:        client.ResumeToken,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "welcome",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,19 @@
+package hello
+
+// HelloData is the data structure for hello messages sent by the client
+// Clients that never send hello are treated as protocol version 1 with no optional features
+type HelloData struct {
+	ProtocolVersion int      `json:"protocol_version"` // Newest protocol version the client speaks
+	Features        []string `json:"features"`         // Optional features the client understands
+}
+
+// WelcomeData is the data structure for the server's reply to hello
+type WelcomeData struct {
+	ProtocolVersion    int      `json:"protocol_version"`     // Version the server will speak to this client
+	ServerVersion      int      `json:"server_version"`       // Newest protocol version the server speaks
+	MinProtocolVersion int      `json:"min_protocol_version"` // Oldest protocol version the server speaks
+	Capabilities       []string `json:"capabilities"`         // Every optional feature the server supports
+	Features           []string `json:"features"`             // Features enabled for this client (supported by both sides)
+	YourClientID       string   `json:"your_client_id"`
+	ResumeToken        string   `json:"resume_token"` // Present with client_id when reconnecting to reclaim the client ID
+}
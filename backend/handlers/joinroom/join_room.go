@@ -9,51 +9,46 @@ import (
 )
 
 // HandleJoinRoom handles joining an existing room
-func HandleJoinRoom(hub *core.Hub, client *core.Client, roomID, displayName, color string) {
-	// Initialize client profile (generates random if not provided)
-	core.InitializeClientProfile(client, displayName, color)
-
-	// Validate game ID format first
-	if !helpers.IsValidGameID(roomID) {
-		errorMsg, _ := types.NewErrorMessage("Invalid game ID format")
-		client.SafeSend(errorMsg)
-		return
-	}
-
-	// Check if room exists
-	room := hub.GetRoom(roomID)
+// pin is checked if the room is PIN-protected and the client has not entered it before
+func HandleJoinRoom(hub *core.Hub, client *core.Client, roomID, displayName, color, pin string) {
+	room := roomToJoin(hub, client, roomID, displayName, color, pin)
 	if room == nil {
-		errorMsg, _ := types.NewErrorMessage("Room not found")
-		client.SafeSend(errorMsg)
 		return
 	}
 
-	// Check if client is already in another room
-	if client.RoomID != "" && client.RoomID != roomID {
-		oldRoomID := client.RoomID
-		oldRoom := hub.GetRoom(oldRoomID)
-
-		if oldRoom != nil {
-			// Remove client from OLD room (not the new one) using centralized helper
-			// This handles turn cleanup and notifications automatically
-			hub.RemoveClientFromRoom(oldRoomID, client.ClientID, "moved to another room")
-		} else {
-			// Old room doesn't exist - client in inconsistent state
-			log.Printf("Client %s had invalid RoomID %s (room not found), clearing it", client.ClientID, oldRoomID)
-			client.RoomID = ""
-		}
-
-		// Re-validate room still exists after potential leave operation
-		room = hub.GetRoom(roomID)
-		if room == nil {
-			errorMsg, _ := types.NewErrorMessage("Room has been deleted")
-			client.SafeSend(errorMsg)
+	// A player whose connection dropped gets their seat back instead of joining again
+	if hub.Reconnect(room, client) {
+		client.RoomID = roomID
+		client.Spectator = false
+		response := createRoomJoinedMessage(room, client)
+		if response == nil {
 			return
 		}
+		client.SafeSend(response)
+		presenceChangedMsg, err := NewPresenceChangedMessage(roomID, client.ClientID, core.PresenceConnected)
+		if err == nil {
+			// Clients without the presence feature never heard the player was away
+			hub.BroadcastToRoomByFeature(roomID, client, core.FeaturePresence, client.WithRequestID(presenceChangedMsg), nil)
+		}
+		log.Printf("Client %s (%s) returned to room %s", client.ClientID, client.DisplayName, roomID)
+		return
 	}
 
 	// Add client to room first (so they're included in peers list)
-	if !room.AddClient(client) {
+	seated, position := room.AddClientOrWait(client)
+	if position > 0 {
+		// The room is full - wait in line for a seat (see Hub.RemoveClientFromRoom)
+		client.WaitingRoomID = roomID
+		waitlistedMsg, err := NewWaitlistedMessage(roomID, position, room.GetSettings().Capacity)
+		if err != nil {
+			log.Printf("Error creating waitlisted message: %v", err)
+			return
+		}
+		client.SafeSend(client.WithRequestID(waitlistedMsg))
+		log.Printf("Client %s (%s) is waiting for a seat in room %s (position %d)", client.ClientID, client.DisplayName, roomID, position)
+		return
+	}
+	if !seated {
 		// Ensure client.RoomID is set (may be inconsistent)
 		client.RoomID = roomID
 		// If we're receiving a request to join but they are already in	the room
@@ -69,6 +64,7 @@ func HandleJoinRoom(hub *core.Hub, client *core.Client, roomID, displayName, col
 
 	// Update client's room ID
 	client.RoomID = roomID
+	client.Spectator = false
 
 	// Now get peers list (includes the joining client)
 	response := createRoomJoinedMessage(room, client)
@@ -89,15 +85,81 @@ func HandleJoinRoom(hub *core.Hub, client *core.Client, roomID, displayName, col
 
 	// Send messages
 	client.SafeSend(response)
-	hub.BroadcastToRoomExcept(roomID, client, playerJoinedMsg)
+	hub.BroadcastToRoomExcept(roomID, client, client.WithRequestID(playerJoinedMsg))
 	log.Printf("Client %s (%s) joined room %s", client.ClientID, client.DisplayName, roomID)
 }
 
+// roomToJoin validates a join request and returns the room to join
+// Leaves the client's previous room if it is moving to another one
+// Sends an error to the client and returns nil if the room cannot be joined
+func roomToJoin(hub *core.Hub, client *core.Client, roomID, displayName, color, pin string) *core.Room {
+	// Initialize client profile (generates random if not provided)
+	core.InitializeClientProfile(client, displayName, color)
+
+	// Validate game ID format first
+	if !helpers.IsValidGameID(roomID) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid game ID format")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	// Check if room exists
+	room := hub.GetRoom(roomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	// Kicked players stay out for a while, and locked rooms only let former players back in
+	if err := room.CanJoin(client.ClientID); err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeForbidden, err.Error())
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	// PIN-protected rooms only let in clients that know the PIN (wrong guesses are throttled)
+	if err := hub.CheckRoomPIN(room, client, pin); err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeForbidden, err.Error())
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	// A client waiting for a seat elsewhere gives up its place in line
+	if client.WaitingRoomID != roomID {
+		hub.LeaveWaitlist(client)
+	}
+
+	// Check if client is already in another room
+	if client.RoomID != "" && client.RoomID != roomID {
+		oldRoomID := client.RoomID
+		oldRoom := hub.GetRoom(oldRoomID)
+
+		if oldRoom != nil {
+			// Remove client from OLD room (not the new one) using centralized helper
+			// This handles turn cleanup and notifications automatically
+			hub.RemoveClientFromRoom(oldRoomID, client.ClientID, "moved to another room")
+		} else {
+			// Old room doesn't exist - client in inconsistent state
+			log.Printf("Client %s had invalid RoomID %s (room not found), clearing it", client.ClientID, oldRoomID)
+			client.RoomID = ""
+		}
+
+		// Re-validate room still exists after potential leave operation
+		room = hub.GetRoom(roomID)
+		if room == nil {
+			errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room has been deleted")
+			client.SafeSend(errorMsg)
+			return nil
+		}
+	}
+
+	return room
+}
+
 // createRoomJoinedMessage creates a room_joined message
 func createRoomJoinedMessage(room *core.Room, client *core.Client) []byte {
-	peers := room.ListPeerInfo()
-	currentTurn := room.GetCurrentTurnInfo()
-	response, err := NewRoomJoinedMessage(room.ID, client.ClientID, peers, currentTurn)
+	response, err := NewRoomJoinedMessage(room, client)
 	if err != nil {
 		log.Printf("Error creating room_joined message: %v", err)
 		errorMsg, _ := types.NewErrorMessage("Failed to create join message")
@@ -105,5 +167,5 @@ func createRoomJoinedMessage(room *core.Room, client *core.Client) []byte {
 		return nil
 	}
 
-	return response
+	return client.WithRequestID(response)
 }
@@ -20,7 +20,7 @@ func setupTestMessageRouter() core.MessageHandler {
 		case "create_room":
 			var data createroom.CreateRoomData
 			json.Unmarshal(msg.Data, &data)
-			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 		case "join_room":
 			var data JoinRoomData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -29,7 +29,11 @@ func setupTestMessageRouter() core.MessageHandler {
 				return
 			}
 			roomID := strings.ToUpper(data.RoomID)
-			HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color)
+			if data.Spectator {
+				HandleSpectateRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+			} else {
+				HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+			}
 		case "start_turn":
 			var data startturn.StartTurnData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -62,7 +66,10 @@ func TestJoinRoom(t *testing.T) {
 	t.Run("JoinDifferentRoomWithTurnTriggersCallbacks", testJoinDifferentRoomWithTurnTriggersCallbacks)
 	t.Run("JoinDifferentRoomTriggersPlayerLeftCallback", testJoinDifferentRoomTriggersPlayerLeftCallback)
 	t.Run("JoinRoomWithInvalidOldRoomID", testJoinRoomWithInvalidOldRoomID)
-	t.Run("JoinRoomCreatorIsLastInPeers", testJoinRoomCreatorIsLastInPeers)
+	t.Run("JoinRoomPeersInSeatOrder", testJoinRoomPeersInSeatOrder)
+	t.Run("JoinRoomIncludesRound", testJoinRoomIncludesRound)
+	t.Run("JoinRoomWithPIN", testJoinRoomWithPIN)
+	t.Run("JoinRoomWrongPINIsThrottled", testJoinRoomWrongPINIsThrottled)
 }
 
 func testJoinExistingRoom(t *testing.T) {
@@ -885,7 +892,7 @@ func testJoinRoomWithInvalidOldRoomID(t *testing.T) {
 	}
 }
 
-func testJoinRoomCreatorIsLastInPeers(t *testing.T) {
+func testJoinRoomPeersInSeatOrder(t *testing.T) {
 	server := test_helpers.SetupTestServer(setupTestMessageRouter())
 	defer server.Cleanup()
 
@@ -902,6 +909,7 @@ func testJoinRoomCreatorIsLastInPeers(t *testing.T) {
 	creatorID := createData.Peers[0].ClientID
 
 	// Add 2 more clients
+	joinerIDs := make([]string, 0, 2)
 	for i := 0; i < 2; i++ {
 		client, _ := test_helpers.ConnectTestClient(server.Server.URL)
 		defer client.Close()
@@ -909,10 +917,13 @@ func testJoinRoomCreatorIsLastInPeers(t *testing.T) {
 		client.SendMessage("join_room", map[string]interface{}{
 			"room_id": roomID,
 		})
-		client.ReceiveMessage(5 * time.Second)
+		resp, _ := client.ReceiveMessage(5 * time.Second)
+		var data RoomJoinedData
+		json.Unmarshal(resp.Data, &data)
+		joinerIDs = append(joinerIDs, data.YourClientID)
 	}
 
-	// Last client should see creator as last in peers list
+	// Last client should see everyone in join order
 	client3, _ := test_helpers.ConnectTestClient(server.Server.URL)
 	defer client3.Close()
 	time.Sleep(100 * time.Millisecond)
@@ -929,9 +940,140 @@ func testJoinRoomCreatorIsLastInPeers(t *testing.T) {
 		t.Fatalf("Expected 4 peers, got %d", len(joinData.Peers))
 	}
 
-	// Creator should be last
-	lastPeer := joinData.Peers[len(joinData.Peers)-1]
-	if lastPeer.ClientID != creatorID {
-		t.Errorf("Expected creator '%s' to be last, but last peer is '%s'", creatorID, lastPeer.ClientID)
+	// Creator sits first, then joiners in the order they arrived
+	expected := append([]string{creatorID}, joinerIDs...)
+	expected = append(expected, joinData.YourClientID)
+	for i, peer := range joinData.Peers {
+		if peer.ClientID != expected[i] {
+			t.Errorf("Expected seat %d to be '%s', got '%s'", i, expected[i], peer.ClientID)
+		}
+	}
+}
+
+func testJoinRoomIncludesRound(t *testing.T) {
+	server := test_helpers.SetupTestServer(setupTestMessageRouter())
+	defer server.Cleanup()
+
+	client1, _ := test_helpers.ConnectTestClient(server.Server.URL)
+	defer client1.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client1.SendMessage("create_room", map[string]interface{}{})
+	createResp, _ := client1.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+	if createData.Round != 1 {
+		t.Errorf("Expected new room to be in round 1, got %d", createData.Round)
+	}
+
+	// The only player takes and ends a turn, completing round 1
+	client1.SendMessage("start_turn", map[string]interface{}{
+		"new_turn": createData.YourClientID,
+	})
+	client1.ReceiveMessageOfType("turn_changed", 5*time.Second)
+	client1.SendMessage("start_turn", map[string]interface{}{
+		"current_turn": createData.YourClientID,
+	})
+	if _, err := client1.ReceiveMessageOfType("round_completed", 5*time.Second); err != nil {
+		t.Fatalf("Failed to receive round_completed: %v", err)
+	}
+
+	client2, _ := test_helpers.ConnectTestClient(server.Server.URL)
+	defer client2.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client2.SendMessage("join_room", map[string]interface{}{
+		"room_id": createData.RoomID,
+	})
+	joinResp, err := client2.ReceiveMessageOfType("room_joined", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_joined: %v", err)
+	}
+
+	var joinData RoomJoinedData
+	json.Unmarshal(joinResp.Data, &joinData)
+	if joinData.Round != 2 {
+		t.Errorf("Expected late joiner to see round 2, got %d", joinData.Round)
+	}
+}
+
+// createPINRoom connects a host and creates a room protected by pin
+func createPINRoom(t *testing.T, server *test_helpers.TestServer, pin string) (*test_helpers.TestWebSocketClient, createroom.RoomCreatedData) {
+	t.Helper()
+
+	host, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect host: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	host.SendMessage("create_room", map[string]interface{}{"pin": pin})
+	resp, err := host.ReceiveMessageOfType("room_created", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_created: %v", err)
+	}
+	var created createroom.RoomCreatedData
+	json.Unmarshal(resp.Data, &created)
+	if !created.PINRequired {
+		t.Fatal("Expected the room to require a PIN")
+	}
+	return host, created
+}
+
+func testJoinRoomWithPIN(t *testing.T) {
+	server := test_helpers.SetupTestServer(setupTestMessageRouter())
+	defer server.Cleanup()
+
+	host, created := createPINRoom(t, server, "4821")
+	defer host.Close()
+
+	player, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer player.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID})
+	if msg := test_helpers.ReceiveError(t, player).Message; msg != "This room requires a PIN" {
+		t.Errorf("Expected PIN required error, got '%s'", msg)
+	}
+
+	player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID, "pin": "4821"})
+	if _, err := player.ReceiveMessageOfType("room_joined", 5*time.Second); err != nil {
+		t.Fatalf("Failed to join with the right PIN: %v", err)
+	}
+
+	// Once admitted, the client is not asked again
+	player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID})
+	if _, err := player.ReceiveMessageOfType("room_joined", 5*time.Second); err != nil {
+		t.Fatalf("Failed to re-sync without the PIN: %v", err)
+	}
+}
+
+func testJoinRoomWrongPINIsThrottled(t *testing.T) {
+	server := test_helpers.SetupTestServer(setupTestMessageRouter())
+	defer server.Cleanup()
+
+	host, created := createPINRoom(t, server, "4821")
+	defer host.Close()
+
+	player, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer player.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	for i := 0; i < core.MaxPINFailuresPerRoom; i++ {
+		player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID, "pin": "0000"})
+		if msg := test_helpers.ReceiveError(t, player).Message; msg != "Wrong PIN" {
+			t.Fatalf("Attempt %d: expected wrong PIN error, got '%s'", i+1, msg)
+		}
+	}
+
+	// Even the right PIN is refused until the failures expire
+	player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID, "pin": "4821"})
+	if msg := test_helpers.ReceiveError(t, player).Message; msg != "Too many wrong PINs, try again later" {
+		t.Errorf("Expected throttle error, got '%s'", msg)
 	}
 }
@@ -6,19 +6,13 @@ import (
 	"turn-tracker/backend/types"
 )
 
-// NewRoomJoinedMessage creates a room_joined message
-func NewRoomJoinedMessage(roomID, yourClientID string, peers []core.PeerInfo, currentTurn core.PeerInfo) ([]byte, error) {
-	var currentTurnPtr *core.PeerInfo
-	// If currentTurn is not empty (has a ClientID), use it; otherwise set to nil for null in JSON
-	if currentTurn.ClientID != "" {
-		currentTurnPtr = &currentTurn
-	}
-
+// NewRoomJoinedMessage creates a room_joined message with a snapshot of the room
+func NewRoomJoinedMessage(room *core.Room, client *core.Client) ([]byte, error) {
 	data := RoomJoinedData{
-		RoomID:       roomID,
-		YourClientID: yourClientID,
-		Peers:        peers,
-		CurrentTurn:  currentTurnPtr,
+		YourClientID: client.ClientID,
+		ResumeToken:  client.ResumeToken,
+		Spectator:    room.IsSpectator(client.ClientID),
+		RoomSnapshot: room.Snapshot(),
 	}
 	dataJSON, err := json.Marshal(data)
 	if err != nil {
@@ -85,3 +79,101 @@ func NewPlayerLeftMessage(roomID, peerID string) ([]byte, error) {
 	// Copy into pooled buffer for reuse
 	return core.CopyToPooledBuffer(marshaled), nil
 }
+
+// NewPresenceChangedMessage creates a presence_changed message
+func NewPresenceChangedMessage(roomID, peerID, presence string) ([]byte, error) {
+	data := PresenceChangedData{
+		RoomID:   roomID,
+		PeerID:   peerID,
+		Presence: presence,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "presence_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewSessionResumedMessage creates a session_resumed message
+func NewSessionResumedMessage(roomID string, client *core.Client) ([]byte, error) {
+	data := SessionResumedData{
+		RoomID:       roomID,
+		YourClientID: client.ClientID,
+		DisplayName:  client.DisplayName,
+		Color:        client.Color,
+		Spectator:    client.Spectator,
+		ResumeToken:  client.ResumeToken,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "session_resumed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewSpectatorsChangedMessage creates a spectators_changed message
+func NewSpectatorsChangedMessage(roomID string, spectators int) ([]byte, error) {
+	data := SpectatorsChangedData{
+		RoomID:     roomID,
+		Spectators: spectators,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "spectators_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewWaitlistedMessage creates a waitlisted message
+func NewWaitlistedMessage(roomID string, position, capacity int) ([]byte, error) {
+	data := WaitlistedData{
+		RoomID:   roomID,
+		Position: position,
+		Capacity: capacity,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "waitlisted",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,30 @@
+package joinroom
+
+import (
+	"log"
+
+	"turn-tracker/backend/core"
+)
+
+// ResumeSession puts a reconnecting client back into the room it was in when it disconnected
+// The client goes through the usual join checks, so a player whose seat is still held gets it back,
+// and a client that can no longer get in (kicked, room locked or full) is told why instead
+// On success the client receives room_joined followed by session_resumed
+func ResumeSession(hub *core.Hub, client *core.Client, roomID string, spectator bool) {
+	if spectator {
+		HandleSpectateRoom(hub, client, roomID, client.DisplayName, client.Color, "")
+	} else {
+		HandleJoinRoom(hub, client, roomID, client.DisplayName, client.Color, "")
+	}
+	if client.RoomID != roomID {
+		return // Not back in the room (an error or waitlisted message was sent)
+	}
+
+	sessionResumedMsg, err := NewSessionResumedMessage(roomID, client)
+	if err != nil {
+		log.Printf("Error creating session_resumed message: %v", err)
+		return
+	}
+	client.SafeSend(sessionResumedMsg)
+	log.Printf("Client %s resumed its session in room %s", client.ClientID, roomID)
+}
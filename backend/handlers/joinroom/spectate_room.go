@@ -0,0 +1,56 @@
+package joinroom
+
+import (
+	"log"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleSpectateRoom handles joining an existing room as a read-only spectator
+// Spectators receive every broadcast but are never seated, so players only see the spectator count change
+func HandleSpectateRoom(hub *core.Hub, client *core.Client, roomID, displayName, color, pin string) {
+	room := roomToJoin(hub, client, roomID, displayName, color, pin)
+	if room == nil {
+		return
+	}
+	hub.LeaveWaitlist(client) // Watching instead of waiting for a seat
+
+	if !room.AddSpectator(client) {
+		// Already in the room (as a player or spectator) - re-sync without changing roles
+		client.RoomID = roomID
+		response := createRoomJoinedMessage(room, client)
+		if response == nil {
+			return
+		}
+		client.SafeSend(response)
+		log.Printf("Client %s (%s) re-synced room %s state (fallback)", client.ClientID, client.DisplayName, roomID)
+		return
+	}
+
+	// Update client's room ID
+	client.RoomID = roomID
+	client.Spectator = true
+
+	response := createRoomJoinedMessage(room, client)
+	if response == nil {
+		return
+	}
+
+	spectatorsChangedMsg, err := NewSpectatorsChangedMessage(roomID, room.SpectatorCount())
+	if err != nil {
+		log.Printf("Error creating spectators_changed message: %v", err)
+		// Rollback: remove spectator from room
+		room.RemoveClient(client.ClientID)
+		client.RoomID = ""
+		client.Spectator = false
+		errorMsg, _ := types.NewErrorMessage("Failed to create notification message")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Send messages
+	client.SafeSend(response)
+	hub.BroadcastToRoomExcept(roomID, client, client.WithRequestID(spectatorsChangedMsg))
+	log.Printf("Client %s (%s) is spectating room %s", client.ClientID, client.DisplayName, roomID)
+}
@@ -0,0 +1,130 @@
+package joinroom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+)
+
+// TestSpectateRoom wraps all join_room tests for spectators
+func TestSpectateRoom(t *testing.T) {
+	t.Run("SpectatorIsNotAPeer", testSpectatorIsNotAPeer)
+	t.Run("SpectatorReceivesBroadcasts", testSpectatorReceivesBroadcasts)
+	t.Run("SpectatorCannotTakeTurn", testSpectatorCannotTakeTurn)
+}
+
+// setupSpectatedRoom creates a room and adds a spectator to it
+// Returns the host, the spectator and the room created data
+func setupSpectatedRoom(t *testing.T, server *test_helpers.TestServer) (*test_helpers.TestWebSocketClient, *test_helpers.TestWebSocketClient, createroom.RoomCreatedData) {
+	t.Helper()
+
+	host, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect host: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	host.SendMessage("create_room", map[string]interface{}{})
+	resp, err := host.ReceiveMessageOfType("room_created", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_created: %v", err)
+	}
+	var created createroom.RoomCreatedData
+	json.Unmarshal(resp.Data, &created)
+
+	spectator, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect spectator: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	spectator.SendMessage("join_room", map[string]interface{}{
+		"room_id":   created.RoomID,
+		"spectator": true,
+	})
+	return host, spectator, created
+}
+
+func testSpectatorIsNotAPeer(t *testing.T) {
+	server := test_helpers.SetupTestServer(setupTestMessageRouter())
+	defer server.Cleanup()
+
+	host, spectator, created := setupSpectatedRoom(t, server)
+	defer host.Close()
+	defer spectator.Close()
+
+	resp, err := spectator.ReceiveMessageOfType("room_joined", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_joined: %v", err)
+	}
+	var joined RoomJoinedData
+	json.Unmarshal(resp.Data, &joined)
+	if !joined.Spectator || joined.Spectators != 1 {
+		t.Errorf("Expected to join as the only spectator, got spectator=%v spectators=%d", joined.Spectator, joined.Spectators)
+	}
+	if len(joined.Peers) != 1 || joined.Peers[0].ClientID != created.YourClientID {
+		t.Errorf("Expected only the host in peers, got %+v", joined.Peers)
+	}
+
+	// The host only sees the spectator count change, not a new player
+	resp, err = host.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive spectators_changed: %v", err)
+	}
+	if resp.Type != "spectators_changed" {
+		t.Fatalf("Expected 'spectators_changed', got '%s'", resp.Type)
+	}
+	var changed SpectatorsChangedData
+	json.Unmarshal(resp.Data, &changed)
+	if changed.Spectators != 1 {
+		t.Errorf("Expected 1 spectator, got %d", changed.Spectators)
+	}
+}
+
+func testSpectatorReceivesBroadcasts(t *testing.T) {
+	server := test_helpers.SetupTestServer(setupTestMessageRouter())
+	defer server.Cleanup()
+
+	host, spectator, created := setupSpectatedRoom(t, server)
+	defer host.Close()
+	defer spectator.Close()
+	spectator.ReceiveMessageOfType("room_joined", 5*time.Second)
+
+	host.SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": created.YourClientID})
+	resp, err := spectator.ReceiveMessageOfType("turn_changed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Spectator did not receive turn_changed: %v", err)
+	}
+	var turnData startturn.TurnChangedData
+	json.Unmarshal(resp.Data, &turnData)
+	if turnData.CurrentTurn == nil || turnData.CurrentTurn.ClientID != created.YourClientID {
+		t.Errorf("Expected the host's turn, got %+v", turnData.CurrentTurn)
+	}
+}
+
+func testSpectatorCannotTakeTurn(t *testing.T) {
+	server := test_helpers.SetupTestServer(setupTestMessageRouter())
+	defer server.Cleanup()
+
+	host, spectator, _ := setupSpectatedRoom(t, server)
+	defer host.Close()
+	defer spectator.Close()
+
+	resp, _ := spectator.ReceiveMessageOfType("room_joined", 5*time.Second)
+	var joined RoomJoinedData
+	json.Unmarshal(resp.Data, &joined)
+
+	// Spectators are not seated, so a turn for them is a state mismatch
+	host.SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": joined.YourClientID})
+	resp, err := host.ReceiveMessageOfType("turn_changed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive turn state: %v", err)
+	}
+	var turnData startturn.TurnChangedData
+	json.Unmarshal(resp.Data, &turnData)
+	if turnData.CurrentTurn != nil {
+		t.Errorf("Expected no turn for a spectator, got %+v", turnData.CurrentTurn)
+	}
+}
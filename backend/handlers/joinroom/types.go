@@ -7,14 +7,16 @@ type JoinRoomData struct {
 	RoomID      string `json:"room_id"`
 	DisplayName string `json:"display_name,omitempty"`
 	Color       string `json:"color,omitempty"`
+	Spectator   bool   `json:"spectator,omitempty"` // Join as a read-only spectator instead of a player
+	PIN         string `json:"pin,omitempty"`       // Room PIN (only needed the first time a client joins a PIN-protected room)
 }
 
 // RoomJoinedData is the response data structure for room_joined messages
 type RoomJoinedData struct {
-	RoomID      string          `json:"room_id"`
-	YourClientID string         `json:"your_client_id"` // Client ID of the message recipient
-	Peers       []core.PeerInfo `json:"peers"`
-	CurrentTurn *core.PeerInfo  `json:"current_turn,omitempty"` // nil if no turn active
+	YourClientID string `json:"your_client_id"`      // Client ID of the message recipient
+	ResumeToken  string `json:"resume_token"`        // Secret the recipient presents (with its client ID) to reconnect
+	Spectator    bool   `json:"spectator,omitempty"` // True if the recipient is watching as a spectator
+	core.RoomSnapshot
 }
 
 // PlayerJoinedData is the data structure for player_joined messages
@@ -31,3 +33,37 @@ type PlayerLeftData struct {
 	RoomID string `json:"room_id"`
 	PeerID string `json:"peer_id"`
 }
+
+// PresenceChangedData is the data structure for presence_changed messages
+// Sent when a seated player's connection drops (away), when they return (connected),
+// and when they are removed after staying away too long (gone)
+type PresenceChangedData struct {
+	RoomID   string `json:"room_id"`
+	PeerID   string `json:"peer_id"`
+	Presence string `json:"presence"` // connected, away or gone
+}
+
+// SessionResumedData is the data structure for session_resumed messages
+// Sent after room_joined when a reconnecting client is put back into its last room
+type SessionResumedData struct {
+	RoomID       string `json:"room_id"`
+	YourClientID string `json:"your_client_id"`
+	DisplayName  string `json:"display_name"`
+	Color        string `json:"color"`
+	Spectator    bool   `json:"spectator,omitempty"` // True if the client is back as a spectator
+	ResumeToken  string `json:"resume_token"`        // Present with client_id on the next reconnect (the old token no longer works)
+}
+
+// SpectatorsChangedData is the data structure for spectators_changed messages
+type SpectatorsChangedData struct {
+	RoomID     string `json:"room_id"`
+	Spectators int    `json:"spectators"` // Number of spectators now watching
+}
+
+// WaitlistedData is the data structure for waitlisted messages
+// Sent when a client joins a full room, and again whenever its position changes
+type WaitlistedData struct {
+	RoomID   string `json:"room_id"`
+	Position int    `json:"position"` // Place in line (1 = next to get a seat)
+	Capacity int    `json:"capacity"` // Number of seats in the room
+}
@@ -0,0 +1,37 @@
+package joinroom
+
+import (
+	"log"
+
+	"turn-tracker/backend/core"
+)
+
+// AnnounceWaitlistAdmitted tells a client that moved from the waitlist into the room that it is seated,
+// and tells everyone else that it joined
+func AnnounceWaitlistAdmitted(hub *core.Hub, room *core.Room, client *core.Client) {
+	response := createRoomJoinedMessage(room, client)
+	if response == nil {
+		return
+	}
+	client.SafeSend(response)
+
+	playerJoinedMsg, err := NewPlayerJoinedMessage(room.ID, client.ClientID, client.DisplayName, client.Color, client.TotalTurnTime)
+	if err != nil {
+		log.Printf("Error creating player_joined message: %v", err)
+		return
+	}
+	hub.BroadcastToRoomExcept(room.ID, client, playerJoinedMsg)
+}
+
+// SendWaitlistPositions tells every client on the room's waitlist its current position
+func SendWaitlistPositions(room *core.Room) {
+	capacity := room.GetSettings().Capacity
+	for i, client := range room.ListWaitlist() {
+		waitlistedMsg, err := NewWaitlistedMessage(room.ID, i+1, capacity)
+		if err != nil {
+			log.Printf("Error creating waitlisted message: %v", err)
+			return
+		}
+		client.SafeSend(waitlistedMsg)
+	}
+}
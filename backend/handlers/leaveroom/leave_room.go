@@ -12,15 +12,22 @@ func HandleLeaveRoom(hub *core.Hub, client *core.Client, roomID string) {
 	// Normalize to uppercase for consistency
 	roomID = strings.ToUpper(roomID)
 
+	// A client waiting for a seat leaves the waitlist instead
+	if client.RoomID == "" && client.WaitingRoomID == roomID {
+		hub.LeaveWaitlist(client)
+		log.Printf("Client %s left the waitlist of room %s", client.ClientID, roomID)
+		return
+	}
+
 	// Validate roomID matches client's current room
 	if client.RoomID == "" {
-		errorMsg, _ := types.NewErrorMessage("Not in a room")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
 		client.SafeSend(errorMsg)
 		return
 	}
 
 	if client.RoomID != roomID {
-		errorMsg, _ := types.NewErrorMessage("Room ID mismatch")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Room ID mismatch")
 		client.SafeSend(errorMsg)
 		return
 	}
@@ -28,7 +35,7 @@ func HandleLeaveRoom(hub *core.Hub, client *core.Client, roomID string) {
 	// Get room before removing client
 	room := hub.GetRoom(roomID)
 	if room == nil {
-		errorMsg, _ := types.NewErrorMessage("Room not found")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
 		client.SafeSend(errorMsg)
 		return
 	}
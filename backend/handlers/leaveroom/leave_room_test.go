@@ -20,7 +20,7 @@ func setupTestMessageRouter() core.MessageHandler {
 		case "create_room":
 			var data createroom.CreateRoomData
 			json.Unmarshal(msg.Data, &data)
-			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 		case "join_room":
 			var data joinroom.JoinRoomData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -29,7 +29,7 @@ func setupTestMessageRouter() core.MessageHandler {
 				return
 			}
 			roomID := strings.ToUpper(data.RoomID)
-			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
 		case "leave_room":
 			var data LeaveRoomData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -312,7 +312,7 @@ func TestLeaveRoom(t *testing.T) {
 			t.Fatalf("Failed to join room: %v", err)
 		}
 
-		// Get client2ID from room_joined message (creator is last, so joiner is first)
+		// Get client2ID from room_joined message (peers are in seat order, so joiner is last)
 		roomJoined, err := client2.ReceiveMessage(2 * time.Second)
 		if err != nil {
 			t.Fatalf("Failed to receive room_joined: %v", err)
@@ -325,8 +325,8 @@ func TestLeaveRoom(t *testing.T) {
 		}
 		var client2ID string
 		if err := json.Unmarshal(roomJoined.Data, &joinResp); err == nil && len(joinResp.Peers) > 0 {
-			// Creator is last, so joiner (client2) is first
-			client2ID = joinResp.Peers[0].ClientID
+			// Peers are in seat order, so joiner (client2) is last
+			client2ID = joinResp.Peers[len(joinResp.Peers)-1].ClientID
 		} else {
 			// Fallback: get from player_joined
 			playerJoined, err := client1.ReceiveMessage(2 * time.Second)
@@ -0,0 +1,106 @@
+package markready
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandleStartPhase starts a simultaneous phase where every player in the room is active
+// Only valid in rooms whose turn mode is simultaneous
+func HandleStartPhase(hub *core.Hub, client *core.Client) {
+	room, ok := clientRoom(hub, client)
+	if !ok {
+		return
+	}
+
+	phase, err := room.StartPhase(client.ClientID)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+	broadcastReadyChanged(hub, room.ID, phase, client)
+
+	log.Printf("Phase started in room %s by client %s with %d players", room.ID, client.ClientID, len(phase.Waiting))
+}
+
+// HandleMarkReady marks the client as done acting in the current phase
+// Broadcasts the updated ready set, or all_ready if the client was the last one
+func HandleMarkReady(hub *core.Hub, client *core.Client) {
+	room, ok := clientRoom(hub, client)
+	if !ok {
+		return
+	}
+
+	phase, allReady, err := room.MarkReady(client.ClientID)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	if !allReady {
+		broadcastReadyChanged(hub, room.ID, phase, client)
+		log.Printf("Client %s marked ready in room %s (%d still acting)", client.ClientID, room.ID, len(phase.Waiting))
+		return
+	}
+
+	broadcastAllReady(hub, room.ID, phase, client)
+	startturn.BroadcastCompletedRounds(hub, room, client)
+	log.Printf("All players ready in room %s", room.ID)
+}
+
+// BroadcastPhaseState announces a phase change that happened outside mark_ready (e.g. a player leaving)
+// Broadcasts all_ready if the phase ended, or the current ready set if it is still running
+func BroadcastPhaseState(hub *core.Hub, room *core.Room) {
+	if completed := room.TakeCompletedPhase(); completed != nil {
+		broadcastAllReady(hub, room.ID, *completed, nil)
+		startturn.BroadcastCompletedRounds(hub, room, nil)
+		return
+	}
+	if phase := room.GetPhase(); phase != nil {
+		broadcastReadyChanged(hub, room.ID, *phase, nil)
+	}
+}
+
+// broadcastReadyChanged announces who is ready in a running phase
+// origin is the client whose request changed the phase (nil if nobody asked)
+func broadcastReadyChanged(hub *core.Hub, roomID string, phase core.PhaseState, origin *core.Client) {
+	readyChangedMsg, err := NewReadyChangedMessage(roomID, phase)
+	if err != nil {
+		log.Printf("Error creating ready_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(roomID, origin.WithRequestID(readyChangedMsg))
+}
+
+// broadcastAllReady announces that a phase ended
+// origin is the client whose request ended the phase (nil if nobody asked)
+func broadcastAllReady(hub *core.Hub, roomID string, phase core.PhaseState, origin *core.Client) {
+	allReadyMsg, err := NewAllReadyMessage(roomID, phase)
+	if err != nil {
+		log.Printf("Error creating all_ready message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(roomID, origin.WithRequestID(allReadyMsg))
+}
+
+// clientRoom returns the client's room, sending an error to the client if it has none
+func clientRoom(hub *core.Hub, client *core.Client) (*core.Room, bool) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return nil, false
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return nil, false
+	}
+
+	return room, true
+}
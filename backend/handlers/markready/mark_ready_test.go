@@ -0,0 +1,153 @@
+package markready
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "start_turn":
+			var data startturn.StartTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid start_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "start_phase":
+			HandleStartPhase(hub, client)
+		case "mark_ready":
+			HandleMarkReady(hub, client)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+var simultaneous = map[string]interface{}{"turn_mode": "simultaneous"}
+
+func receiveReadyChanged(t *testing.T, client *test_helpers.TestWebSocketClient) ReadyChangedData {
+	t.Helper()
+	resp, err := client.ReceiveMessageOfType("ready_changed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive ready_changed: %v", err)
+	}
+	var data ReadyChangedData
+	json.Unmarshal(resp.Data, &data)
+	return data
+}
+
+// TestMarkReady wraps all start_phase and mark_ready tests
+// This allows running all tests together or individually in the IDE
+func TestMarkReady(t *testing.T) {
+	t.Run("FullPhase", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, simultaneous)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_phase", map[string]interface{}{})
+		for _, c := range clients {
+			data := receiveReadyChanged(t, c)
+			if len(data.Waiting) != 2 || len(data.Ready) != 0 {
+				t.Errorf("Expected both players waiting, got %+v", data)
+			}
+		}
+
+		clients[1].SendMessage("mark_ready", map[string]interface{}{})
+		for _, c := range clients {
+			data := receiveReadyChanged(t, c)
+			if len(data.Ready) != 1 || data.Ready[0].ClientID != clientIDs[1] {
+				t.Errorf("Expected %s ready, got %+v", clientIDs[1], data.Ready)
+			}
+		}
+
+		clients[0].SendMessage("mark_ready", map[string]interface{}{})
+		for _, c := range clients {
+			resp, err := c.ReceiveMessageOfType("all_ready", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive all_ready: %v", err)
+			}
+			var data AllReadyData
+			json.Unmarshal(resp.Data, &data)
+			if len(data.Players) != 2 || data.Players[0].ClientID != clientIDs[1] || data.Players[1].ClientID != clientIDs[0] {
+				t.Errorf("Expected players in ready order, got %+v", data.Players)
+			}
+			if _, err := c.ReceiveMessageOfType("round_completed", 5*time.Second); err != nil {
+				t.Errorf("Expected round_completed after all_ready: %v", err)
+			}
+		}
+	})
+
+	t.Run("SequentialRoomRejectsPhase", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, nil)
+		defer room.Close()
+		clients := room.Clients
+		defer clients[0].Close()
+
+		clients[0].SendMessage("start_phase", map[string]interface{}{})
+		if msg := test_helpers.ReceiveError(t, clients[0]).Message; msg != "Room is not in simultaneous mode" {
+			t.Errorf("Expected simultaneous mode error, got '%s'", msg)
+		}
+	})
+
+	t.Run("SimultaneousRoomRejectsStartTurn", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, simultaneous)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+		defer clients[0].Close()
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		if msg := test_helpers.ReceiveError(t, clients[0]).Message; msg != "Room is in simultaneous mode" {
+			t.Errorf("Expected sequential mode error, got '%s'", msg)
+		}
+	})
+
+	t.Run("NoPhaseInProgress", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, simultaneous)
+		defer room.Close()
+		clients := room.Clients
+		defer clients[0].Close()
+
+		clients[0].SendMessage("mark_ready", map[string]interface{}{})
+		if msg := test_helpers.ReceiveError(t, clients[0]).Message; msg != "No phase in progress" {
+			t.Errorf("Expected 'No phase in progress', got '%s'", msg)
+		}
+	})
+}
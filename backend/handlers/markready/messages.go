@@ -0,0 +1,54 @@
+package markready
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewReadyChangedMessage creates a ready_changed message
+func NewReadyChangedMessage(roomID string, phase core.PhaseState) ([]byte, error) {
+	data := ReadyChangedData{
+		RoomID:     roomID,
+		PhaseState: phase,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "ready_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewAllReadyMessage creates an all_ready message for a finished phase
+func NewAllReadyMessage(roomID string, phase core.PhaseState) ([]byte, error) {
+	data := AllReadyData{
+		RoomID:    roomID,
+		StartTime: phase.StartTime,
+		Players:   phase.Ready,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "all_ready",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,18 @@
+package markready
+
+import "turn-tracker/backend/core"
+
+// ReadyChangedData is the data structure for ready_changed messages
+// Sent when a phase starts and whenever a player marks ready
+type ReadyChangedData struct {
+	RoomID string `json:"room_id"`
+	core.PhaseState
+}
+
+// AllReadyData is the data structure for all_ready messages
+// Sent when the last player marks ready, ending the phase
+type AllReadyData struct {
+	RoomID    string             `json:"room_id"`
+	StartTime int64              `json:"start_time"` // Unix timestamp in milliseconds when the phase started
+	Players   []core.ReadyPlayer `json:"players"`    // Every player's time in the phase, in the order they marked ready
+}
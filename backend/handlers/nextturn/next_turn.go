@@ -0,0 +1,40 @@
+package nextturn
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandleNextTurn advances the turn to the next seat in the room's seating order
+// Uses optimistic concurrency: client sends their view of current turn, server validates
+// If no turn is active, play continues after the seat that last had a turn
+func HandleNextTurn(hub *core.Hub, client *core.Client, expectedCurrentTurn string) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Advance atomically (validates state and sets in one operation)
+	nextClientID, err := room.AdvanceTurn(expectedCurrentTurn, client.ClientID)
+	if err != nil {
+		startturn.RejectTurnChange(client, room, err, expectedCurrentTurn)
+		return
+	}
+
+	// Successfully advanced - broadcast updated state to all players in room
+	startturn.BroadcastTurnChanged(hub, room, client)
+
+	log.Printf("Turn advanced to client %s in room %s by client %s", nextClientID, client.RoomID, client.ClientID)
+}
@@ -0,0 +1,165 @@
+package nextturn
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "next_turn":
+			var data NextTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid next_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			HandleNextTurn(hub, client, data.CurrentTurn)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// TestNextTurn wraps all next_turn tests
+// This allows running all tests together or individually in the IDE
+func TestNextTurn(t *testing.T) {
+	t.Run("AdvancesInSeatOrder", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 3, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		expected := []string{clientIDs[0], clientIDs[1], clientIDs[2], clientIDs[0]}
+		current := ""
+		for _, want := range expected {
+			clients[1].SendMessage("next_turn", map[string]interface{}{"current_turn": current})
+			data := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+			if data.CurrentTurn == nil || data.CurrentTurn.ClientID != want {
+				t.Fatalf("Expected turn for %s, got %+v", want, data.CurrentTurn)
+			}
+			current = want
+		}
+	})
+
+	t.Run("BroadcastsToAllPlayers", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("next_turn", map[string]interface{}{"current_turn": ""})
+		for _, c := range clients {
+			data := test_helpers.ReceiveData[startturn.TurnChangedData](t, c, "turn_changed")
+			if data.CurrentTurn == nil || data.CurrentTurn.ClientID != clientIDs[0] {
+				t.Errorf("Expected turn for %s, got %+v", clientIDs[0], data.CurrentTurn)
+			}
+			if data.TurnStartTime == nil {
+				t.Error("Expected TurnStartTime to be set")
+			}
+		}
+	})
+
+	t.Run("StateMismatchSendsSync", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("next_turn", map[string]interface{}{"current_turn": ""})
+		first := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+
+		// Client 1 still thinks no turn is active
+		clients[1].SendMessage("next_turn", map[string]interface{}{"current_turn": ""})
+		sync := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+		if sync.CurrentTurn == nil || sync.CurrentTurn.ClientID != clientIDs[0] {
+			t.Errorf("Expected state sync with turn for %s, got %+v", clientIDs[0], sync.CurrentTurn)
+		}
+		if sync.Sequence <= first.Sequence {
+			t.Errorf("Expected newer sequence than %d, got %d", first.Sequence, sync.Sequence)
+		}
+	})
+
+	t.Run("AnnouncesRoundCompleted", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("next_turn", map[string]interface{}{"current_turn": ""})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[0], "turn_changed")
+		clients[0].SendMessage("next_turn", map[string]interface{}{"current_turn": clientIDs[0]})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[0], "turn_changed")
+		clients[0].SendMessage("next_turn", map[string]interface{}{"current_turn": clientIDs[1]})
+
+		for _, c := range clients {
+			resp, err := c.ReceiveMessageOfType("round_completed", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive round_completed: %v", err)
+			}
+			var data startturn.RoundCompletedData
+			json.Unmarshal(resp.Data, &data)
+			if data.Round != 1 || data.NextRound != 2 || len(data.Players) != 2 {
+				t.Fatalf("Unexpected round_completed data: %+v", data)
+			}
+			for i, player := range data.Players {
+				if player.ClientID != clientIDs[i] || player.Turns != 1 {
+					t.Errorf("Expected %s with 1 turn, got %+v", clientIDs[i], player)
+				}
+			}
+		}
+	})
+
+	t.Run("NotInRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("next_turn", map[string]interface{}{"current_turn": ""})
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		if resp.Type != "error" {
+			t.Errorf("Expected 'error', got '%s'", resp.Type)
+		}
+	})
+}
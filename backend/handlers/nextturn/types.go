@@ -0,0 +1,6 @@
+package nextturn
+
+// NextTurnData is the data structure for next_turn messages
+type NextTurnData struct {
+	CurrentTurn string `json:"current_turn"` // Client's view of current turn (empty string if no turn)
+}
@@ -0,0 +1,31 @@
+package passturn
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewPlayerPassedMessage creates a player_passed message
+func NewPlayerPassedMessage(roomID, clientID string, allPassed bool) ([]byte, error) {
+	data := PlayerPassedData{
+		RoomID:    roomID,
+		ClientID:  clientID,
+		AllPassed: allPassed,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "player_passed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,46 @@
+package passturn
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandlePassTurn passes the client's turn for the rest of the round
+// The turn advances to the next player who has not passed; once everyone has passed
+// the round completes and all passes are cleared
+func HandlePassTurn(hub *core.Hub, client *core.Client) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	allPassed, err := room.PassTurn(client.ClientID)
+	if err != nil {
+		startturn.RejectTurnChange(client, room, err, "")
+		return
+	}
+
+	playerPassedMsg, err := NewPlayerPassedMessage(client.RoomID, client.ClientID, allPassed)
+	if err != nil {
+		log.Printf("Error creating player_passed message: %v", err)
+	} else {
+		hub.BroadcastToRoom(client.RoomID, client.WithRequestID(playerPassedMsg))
+	}
+
+	// Announces round_completed if everyone has passed, then the new turn
+	startturn.BroadcastTurnChanged(hub, room, client)
+
+	log.Printf("Client %s passed in room %s (all passed: %v)", client.ClientID, client.RoomID, allPassed)
+}
@@ -0,0 +1,142 @@
+package passturn
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "start_turn":
+			var data startturn.StartTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid start_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "pass_turn":
+			HandlePassTurn(hub, client)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+func receivePlayerPassed(t *testing.T, client *test_helpers.TestWebSocketClient) PlayerPassedData {
+	t.Helper()
+	resp, err := client.ReceiveMessageOfType("player_passed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive player_passed: %v", err)
+	}
+	var data PlayerPassedData
+	json.Unmarshal(resp.Data, &data)
+	return data
+}
+
+// TestPassTurn wraps all pass_turn tests
+// This allows running all tests together or individually in the IDE
+func TestPassTurn(t *testing.T) {
+	t.Run("PassAdvancesTurn", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 3, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[0], "turn_changed")
+
+		clients[0].SendMessage("pass_turn", map[string]interface{}{})
+		for _, c := range clients {
+			passed := receivePlayerPassed(t, c)
+			if passed.ClientID != clientIDs[0] || passed.AllPassed {
+				t.Errorf("Unexpected player_passed data: %+v", passed)
+			}
+			turn := test_helpers.ReceiveData[startturn.TurnChangedData](t, c, "turn_changed")
+			if turn.CurrentTurn == nil || turn.CurrentTurn.ClientID != clientIDs[1] {
+				t.Errorf("Expected turn for %s, got %+v", clientIDs[1], turn.CurrentTurn)
+			}
+		}
+	})
+
+	t.Run("EveryonePassedCompletesRound", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+		clients[0].SendMessage("pass_turn", map[string]interface{}{})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+
+		clients[1].SendMessage("pass_turn", map[string]interface{}{})
+		passed := receivePlayerPassed(t, clients[1])
+		if !passed.AllPassed {
+			t.Error("Expected all_passed after the last player passed")
+		}
+		resp, err := clients[1].ReceiveMessageOfType("round_completed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive round_completed: %v", err)
+		}
+		var round startturn.RoundCompletedData
+		json.Unmarshal(resp.Data, &round)
+		if round.Round != 1 {
+			t.Errorf("Expected round 1 to complete, got %d", round.Round)
+		}
+		if turn := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed"); turn.CurrentTurn != nil {
+			t.Errorf("Expected no active turn after everyone passed, got %+v", turn.CurrentTurn)
+		}
+	})
+
+	t.Run("NotYourTurn", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+
+		clients[1].SendMessage("pass_turn", map[string]interface{}{})
+		resp, err := clients[1].ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "You can only pass on your own turn" {
+			t.Errorf("Expected own-turn error, got '%s'", data.Message)
+		}
+	})
+}
@@ -0,0 +1,8 @@
+package passturn
+
+// PlayerPassedData is the data structure for player_passed messages
+type PlayerPassedData struct {
+	RoomID    string `json:"room_id"`
+	ClientID  string `json:"client_id"`  // Player who passed
+	AllPassed bool   `json:"all_passed"` // Everyone has passed - the round is over and all passes are cleared
+}
@@ -0,0 +1,56 @@
+package pausegame
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewGamePausedMessage creates a game_paused message
+func NewGamePausedMessage(roomID, pausedBy string, pausedAt int64) ([]byte, error) {
+	data := GamePausedData{
+		RoomID:   roomID,
+		PausedBy: pausedBy,
+		PausedAt: pausedAt,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "game_paused",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewGameResumedMessage creates a game_resumed message
+func NewGameResumedMessage(roomID, resumedBy string, resumedAt, pausedMs int64) ([]byte, error) {
+	data := GameResumedData{
+		RoomID:    roomID,
+		ResumedBy: resumedBy,
+		ResumedAt: resumedAt,
+		PausedMs:  pausedMs,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "game_resumed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,89 @@
+package pausegame
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandlePauseGame freezes the game clock for the client's room
+// The active turn stops accumulating time and its timers are stopped until the game is resumed
+func HandlePauseGame(hub *core.Hub, client *core.Client) {
+	room, ok := pausableRoom(hub, client)
+	if !ok {
+		return
+	}
+
+	pausedAt, err := room.Pause(client.ClientID)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeNoChange)
+		return
+	}
+	hub.StopTurnTimers(room.ID)
+
+	gamePausedMsg, err := NewGamePausedMessage(room.ID, client.ClientID, pausedAt)
+	if err != nil {
+		log.Printf("Error creating game_paused message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(gamePausedMsg))
+
+	log.Printf("Game paused in room %s by client %s", room.ID, client.ClientID)
+}
+
+// HandleResumeGame restarts the game clock for the client's room
+// The active turn is re-announced with its start time shifted past the pause
+func HandleResumeGame(hub *core.Hub, client *core.Client) {
+	room, ok := pausableRoom(hub, client)
+	if !ok {
+		return
+	}
+
+	resumedAt, pausedMs, err := room.Resume()
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeNoChange)
+		return
+	}
+
+	gameResumedMsg, err := NewGameResumedMessage(room.ID, client.ClientID, resumedAt, pausedMs)
+	if err != nil {
+		log.Printf("Error creating game_resumed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(gameResumedMsg))
+
+	// Re-arm the timers and send the shifted turn start time
+	if room.GetCurrentTurn() != "" {
+		startturn.BroadcastTurnChanged(hub, room, client)
+	}
+
+	log.Printf("Game resumed in room %s by client %s after %dms", room.ID, client.ClientID, pausedMs)
+}
+
+// pausableRoom returns the client's room if the client may pause or resume it
+// Sends an error to the client and returns false otherwise
+func pausableRoom(hub *core.Hub, client *core.Client) (*core.Room, bool) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return nil, false
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return nil, false
+	}
+
+	if room.GetSettings().PauseHostOnly && !room.IsHost(client.ClientID) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeForbidden, "Only the host can pause the game")
+		client.SafeSend(errorMsg)
+		return nil, false
+	}
+
+	return room, true
+}
@@ -0,0 +1,204 @@
+package pausegame
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "start_turn":
+			var data startturn.StartTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid start_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "pause_game":
+			HandlePauseGame(hub, client)
+		case "resume_game":
+			HandleResumeGame(hub, client)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// TestPauseGame wraps all pause_game and resume_game tests
+// This allows running all tests together or individually in the IDE
+func TestPauseGame(t *testing.T) {
+	t.Run("BroadcastsPauseAndResume", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[1].SendMessage("pause_game", map[string]interface{}{})
+		for _, c := range clients {
+			resp, err := c.ReceiveMessageOfType("game_paused", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive game_paused: %v", err)
+			}
+			var data GamePausedData
+			json.Unmarshal(resp.Data, &data)
+			if data.PausedBy != clientIDs[1] || data.PausedAt == 0 {
+				t.Errorf("Unexpected game_paused data: %+v", data)
+			}
+		}
+
+		clients[0].SendMessage("resume_game", map[string]interface{}{})
+		for _, c := range clients {
+			resp, err := c.ReceiveMessageOfType("game_resumed", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive game_resumed: %v", err)
+			}
+			var data GameResumedData
+			json.Unmarshal(resp.Data, &data)
+			if data.ResumedBy != clientIDs[0] || data.ResumedAt == 0 {
+				t.Errorf("Unexpected game_resumed data: %+v", data)
+			}
+		}
+	})
+
+	t.Run("ResumeShiftsTurnStart", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		resp, err := clients[0].ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+		var started startturn.TurnChangedData
+		json.Unmarshal(resp.Data, &started)
+
+		clients[0].SendMessage("pause_game", map[string]interface{}{})
+		clients[0].ReceiveMessageOfType("game_paused", 5*time.Second)
+		time.Sleep(200 * time.Millisecond)
+		clients[0].SendMessage("resume_game", map[string]interface{}{})
+
+		resp, err = clients[0].ReceiveMessageOfType("game_resumed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive game_resumed: %v", err)
+		}
+		var resumed GameResumedData
+		json.Unmarshal(resp.Data, &resumed)
+
+		resp, err = clients[0].ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed after resume: %v", err)
+		}
+		var shifted startturn.TurnChangedData
+		json.Unmarshal(resp.Data, &shifted)
+		if shifted.TurnStartTime == nil {
+			t.Fatal("Expected turn to still be active after resume")
+		}
+		// Allow 1ms of rounding between nanosecond and millisecond timestamps
+		if diff := *shifted.TurnStartTime - *started.TurnStartTime - resumed.PausedMs; diff < -1 || diff > 1 {
+			t.Errorf("Expected turn start shifted by %dms, got %d -> %d", resumed.PausedMs, *started.TurnStartTime, *shifted.TurnStartTime)
+		}
+		if resumed.PausedMs < 200 {
+			t.Errorf("Expected at least 200ms paused, got %d", resumed.PausedMs)
+		}
+	})
+
+	t.Run("StartTurnRejectedWhilePaused", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("pause_game", map[string]interface{}{})
+		clients[0].ReceiveMessageOfType("game_paused", 5*time.Second)
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[1]})
+		if data := test_helpers.ReceiveError(t, clients[0]); data.Message != "Game is paused" {
+			t.Errorf("Expected 'Game is paused', got '%s'", data.Message)
+		}
+	})
+
+	t.Run("AlreadyPaused", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients := room.Clients
+
+		clients[0].SendMessage("pause_game", map[string]interface{}{})
+		clients[1].ReceiveMessageOfType("game_paused", 5*time.Second)
+		clients[1].SendMessage("pause_game", map[string]interface{}{})
+		if data := test_helpers.ReceiveError(t, clients[1]); data.Message != "Game is already paused" || data.Code != types.ErrorCodeNoChange {
+			t.Errorf("Expected '%s' error 'Game is already paused', got %+v", types.ErrorCodeNoChange, data)
+		}
+	})
+
+	t.Run("HostOnly", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, map[string]interface{}{"pause_host_only": true})
+		defer room.Close()
+		clients := room.Clients
+
+		clients[1].SendMessage("pause_game", map[string]interface{}{})
+		if data := test_helpers.ReceiveError(t, clients[1]); data.Message != "Only the host can pause the game" || data.Code != types.ErrorCodeForbidden {
+			t.Errorf("Expected '%s' host-only error, got %+v", types.ErrorCodeForbidden, data)
+		}
+
+		clients[0].SendMessage("pause_game", map[string]interface{}{})
+		if _, err := clients[0].ReceiveMessageOfType("game_paused", 5*time.Second); err != nil {
+			t.Errorf("Expected host to pause the game: %v", err)
+		}
+	})
+
+	t.Run("NotInRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("pause_game", map[string]interface{}{})
+		if data := test_helpers.ReceiveError(t, client); data.Message != "Not in a room" || data.Code != types.ErrorCodeNotInRoom {
+			t.Errorf("Expected '%s' error 'Not in a room', got %+v", types.ErrorCodeNotInRoom, data)
+		}
+	})
+}
@@ -0,0 +1,16 @@
+package pausegame
+
+// GamePausedData is the data structure for game_paused messages
+type GamePausedData struct {
+	RoomID   string `json:"room_id"`
+	PausedBy string `json:"paused_by"` // Client ID that paused the game
+	PausedAt int64  `json:"paused_at"` // Unix timestamp in milliseconds
+}
+
+// GameResumedData is the data structure for game_resumed messages
+type GameResumedData struct {
+	RoomID    string `json:"room_id"`
+	ResumedBy string `json:"resumed_by"` // Client ID that resumed the game
+	ResumedAt int64  `json:"resumed_at"` // Unix timestamp in milliseconds
+	PausedMs  int64  `json:"paused_ms"`  // How long the game was paused (in milliseconds)
+}
@@ -0,0 +1,58 @@
+package randomize
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewFirstPlayerPickedMessage creates a first_player_picked message
+func NewFirstPlayerPickedMessage(roomID string, candidates []string, clientID string, turnStarted bool, pickedBy string) ([]byte, error) {
+	data := FirstPlayerPickedData{
+		RoomID:      roomID,
+		Candidates:  candidates,
+		ClientID:    clientID,
+		TurnStarted: turnStarted,
+		PickedBy:    pickedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "first_player_picked",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewTurnOrderShuffledMessage creates a turn_order_shuffled message
+func NewTurnOrderShuffledMessage(roomID string, candidates, order []string, changedBy string) ([]byte, error) {
+	data := TurnOrderShuffledData{
+		RoomID:     roomID,
+		Candidates: candidates,
+		Order:      order,
+		ChangedBy:  changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "turn_order_shuffled",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,56 @@
+package randomize
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandlePickFirstPlayer handles picking a random player to go first
+// If startTurn is set, the picked player's turn is started too, with the same checks as start_turn
+// (expectedCurrentTurn is the client's view of the current turn, validated before starting)
+func HandlePickFirstPlayer(hub *core.Hub, client *core.Client, startTurn bool, expectedCurrentTurn string) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	candidates, picked, err := room.PickRandomPlayer()
+	if err != nil {
+		startturn.RejectTurnChange(client, room, err, expectedCurrentTurn)
+		return
+	}
+
+	if startTurn {
+		// The room checks the turn may change when starting it; if not, nothing was picked
+		if err := room.SetCurrentTurn(expectedCurrentTurn, picked, client.ClientID); err != nil {
+			startturn.RejectTurnChange(client, room, err, expectedCurrentTurn)
+			return
+		}
+	}
+
+	// Announce the pick before the turn change so clients can animate the draw first
+	pickedMsg, err := NewFirstPlayerPickedMessage(room.ID, candidates, picked, startTurn, client.ClientID)
+	if err != nil {
+		log.Printf("Error creating first_player_picked message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(pickedMsg))
+
+	if startTurn {
+		startturn.BroadcastTurnChanged(hub, room, client)
+	}
+
+	log.Printf("Client %s picked to go first in room %s by client %s", picked, room.ID, client.ClientID)
+}
@@ -0,0 +1,152 @@
+package randomize
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/pausegame"
+	"turn-tracker/backend/handlers/setturnorder"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			json.Unmarshal(msg.Data, &data)
+			joinroom.HandleJoinRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.PIN)
+		case "pause_game":
+			pausegame.HandlePauseGame(hub, client)
+		case "pick_first_player":
+			var data PickFirstPlayerData
+			json.Unmarshal(msg.Data, &data)
+			HandlePickFirstPlayer(hub, client, data.StartTurn, data.CurrentTurn)
+		case "shuffle_order":
+			HandleShuffleOrder(hub, client)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// TestRandomize wraps all first player and shuffle tests
+// This allows running all tests together or individually in the IDE
+func TestRandomize(t *testing.T) {
+	t.Run("PickFirstPlayer", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player := room.Clients[0], room.Clients[1]
+
+		host.SendMessage("pick_first_player", map[string]interface{}{})
+		resp, err := player.ReceiveMessageOfType("first_player_picked", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive first_player_picked: %v", err)
+		}
+		var picked FirstPlayerPickedData
+		json.Unmarshal(resp.Data, &picked)
+		if len(picked.Candidates) != 2 || picked.TurnStarted {
+			t.Errorf("Expected two candidates and no turn started, got %+v", picked)
+		}
+		if picked.ClientID != picked.Candidates[0] && picked.ClientID != picked.Candidates[1] {
+			t.Errorf("Expected the pick among the candidates, got %+v", picked)
+		}
+	})
+
+	t.Run("PickFirstPlayerAndStartTurn", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player := room.Clients[0], room.Clients[1]
+
+		host.SendMessage("pick_first_player", map[string]interface{}{"start_turn": true, "current_turn": ""})
+		resp, err := player.ReceiveMessageOfType("first_player_picked", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive first_player_picked: %v", err)
+		}
+		var picked FirstPlayerPickedData
+		json.Unmarshal(resp.Data, &picked)
+		if !picked.TurnStarted {
+			t.Errorf("Expected the turn started, got %+v", picked)
+		}
+
+		resp, err = player.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+		var turn startturn.TurnChangedData
+		json.Unmarshal(resp.Data, &turn)
+		if turn.CurrentTurn == nil || turn.CurrentTurn.ClientID != picked.ClientID {
+			t.Errorf("Expected turn for %s, got %+v", picked.ClientID, turn.CurrentTurn)
+		}
+	})
+
+	t.Run("PickAndStartWhilePaused", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host := room.Clients[0]
+
+		host.SendMessage("pause_game", map[string]interface{}{})
+		host.ReceiveMessageOfType("game_paused", 5*time.Second)
+
+		host.SendMessage("pick_first_player", map[string]interface{}{"start_turn": true})
+		resp, err := host.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "Game is paused" {
+			t.Errorf("Expected 'Game is paused', got '%s'", data.Message)
+		}
+	})
+
+	t.Run("ShuffleOrder", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player := room.Clients[0], room.Clients[1]
+
+		player.SendMessage("shuffle_order", map[string]interface{}{})
+		resp, err := host.ReceiveMessageOfType("turn_order_shuffled", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_order_shuffled: %v", err)
+		}
+		var shuffled TurnOrderShuffledData
+		json.Unmarshal(resp.Data, &shuffled)
+		if len(shuffled.Candidates) != 2 || len(shuffled.Order) != 2 {
+			t.Errorf("Expected two candidates and two seats, got %+v", shuffled)
+		}
+
+		resp, err = host.ReceiveMessageOfType("turn_order_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_order_changed: %v", err)
+		}
+		var changed setturnorder.TurnOrderChangedData
+		json.Unmarshal(resp.Data, &changed)
+		if len(changed.Order) != 2 || changed.Order[0] != shuffled.Order[0] || changed.Order[1] != shuffled.Order[1] {
+			t.Errorf("Expected turn_order_changed to match the shuffle, got %v and %v", changed.Order, shuffled.Order)
+		}
+	})
+}
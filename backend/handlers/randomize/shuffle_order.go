@@ -0,0 +1,50 @@
+package randomize
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/setturnorder"
+	"turn-tracker/backend/types"
+)
+
+// HandleShuffleOrder handles putting the seats in a random order
+// turn_order_shuffled is followed by the usual turn_order_changed, so clients that do not animate
+// the shuffle still see the new order
+func HandleShuffleOrder(hub *core.Hub, client *core.Client) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	candidates, order, err := room.ShuffleSeatOrder(client.ClientID)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	shuffledMsg, err := NewTurnOrderShuffledMessage(room.ID, candidates, order, client.ClientID)
+	if err != nil {
+		log.Printf("Error creating turn_order_shuffled message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(shuffledMsg))
+
+	turnOrderChangedMsg, err := setturnorder.NewTurnOrderChangedMessage(room.ID, order, client.ClientID)
+	if err != nil {
+		log.Printf("Error creating turn_order_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(turnOrderChangedMsg))
+
+	log.Printf("Turn order shuffled in room %s by client %s", room.ID, client.ClientID)
+}
@@ -0,0 +1,26 @@
+package randomize
+
+// PickFirstPlayerData is the data structure for pick_first_player messages
+type PickFirstPlayerData struct {
+	StartTurn   bool   `json:"start_turn,omitempty"` // Also start the picked player's turn
+	CurrentTurn string `json:"current_turn"`         // Client's view of current turn (only checked when start_turn is set)
+}
+
+// FirstPlayerPickedData is the data structure for first_player_picked messages
+// Candidates lets clients animate the draw before revealing the pick
+type FirstPlayerPickedData struct {
+	RoomID      string   `json:"room_id"`
+	Candidates  []string `json:"candidates"`   // Client IDs of every connected player in seating order
+	ClientID    string   `json:"client_id"`    // Client ID of the picked player
+	TurnStarted bool     `json:"turn_started"` // The picked player's turn was started (turn_changed follows)
+	PickedBy    string   `json:"picked_by"`    // Client ID that asked for the pick
+}
+
+// TurnOrderShuffledData is the data structure for turn_order_shuffled messages
+// Candidates lets clients animate the shuffle before revealing the new order
+type TurnOrderShuffledData struct {
+	RoomID     string   `json:"room_id"`
+	Candidates []string `json:"candidates"` // Client IDs in seating order before the shuffle
+	Order      []string `json:"order"`      // Client IDs in the new seating order
+	ChangedBy  string   `json:"changed_by"` // Client ID that shuffled the order
+}
@@ -0,0 +1,53 @@
+package rolldice
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewDiceRolledMessage creates a dice_rolled message
+func NewDiceRolledMessage(roomID string, roll core.DiceRoll) ([]byte, error) {
+	data := DiceRolledData{
+		RoomID:   roomID,
+		DiceRoll: roll,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "dice_rolled",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewDiceHistoryMessage creates a dice_history message
+func NewDiceHistoryMessage(roomID string, page core.DiceHistoryPage) ([]byte, error) {
+	data := DiceHistoryData{
+		RoomID:          roomID,
+		DiceHistoryPage: page,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "dice_history",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
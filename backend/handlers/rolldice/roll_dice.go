@@ -0,0 +1,71 @@
+package rolldice
+
+import (
+	"log"
+	"strings"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleRollDice handles rolling dice on the server and sends the result to everyone in the room
+func HandleRollDice(hub *core.Hub, client *core.Client, notation string) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	roll, err := room.RollDice(client.ClientID, strings.TrimSpace(notation))
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeInvalidData)
+		return
+	}
+
+	diceRolledMsg, err := NewDiceRolledMessage(room.ID, roll)
+	if err != nil {
+		log.Printf("Error creating dice_rolled message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(diceRolledMsg))
+	log.Printf("Client %s rolled %s in room %s: %v = %d", client.ClientID, roll.Notation, room.ID, roll.Dice, roll.Total)
+}
+
+// HandleGetDiceHistory sends a page of the room's dice history to the requesting client
+func HandleGetDiceHistory(hub *core.Hub, client *core.Client, offset, limit int) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	if offset < 0 || limit < 0 {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid history range")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	historyMsg, err := NewDiceHistoryMessage(room.ID, room.GetDiceHistory(offset, limit))
+	if err != nil {
+		log.Printf("Error creating dice_history message: %v", err)
+		return
+	}
+	client.SafeSend(client.WithRequestID(historyMsg))
+}
@@ -0,0 +1,144 @@
+package rolldice
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			json.Unmarshal(msg.Data, &data)
+			joinroom.HandleJoinRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.PIN)
+		case "roll_dice":
+			var data RollDiceData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid roll_dice data")
+				client.Send <- errorMsg
+				return
+			}
+			HandleRollDice(hub, client, data.Notation)
+		case "get_dice_history":
+			var data GetDiceHistoryData
+			json.Unmarshal(msg.Data, &data)
+			HandleGetDiceHistory(hub, client, data.Offset, data.Limit)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// TestRollDice wraps all dice tests
+// This allows running all tests together or individually in the IDE
+func TestRollDice(t *testing.T) {
+	t.Run("RollBroadcastToRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		host, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect host: %v", err)
+		}
+		defer host.Close()
+		time.Sleep(100 * time.Millisecond)
+		host.SendMessage("create_room", map[string]interface{}{})
+		resp, _ := host.ReceiveMessageOfType("room_created", 5*time.Second)
+		var created createroom.RoomCreatedData
+		json.Unmarshal(resp.Data, &created)
+
+		player, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect player: %v", err)
+		}
+		defer player.Close()
+		time.Sleep(100 * time.Millisecond)
+		player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID})
+		if _, err := player.ReceiveMessageOfType("room_joined", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+
+		host.SendMessage("roll_dice", map[string]interface{}{"notation": "4d6kh3"})
+		resp, err = player.ReceiveMessageOfType("dice_rolled", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive dice_rolled: %v", err)
+		}
+		var rolled DiceRolledData
+		json.Unmarshal(resp.Data, &rolled)
+		if rolled.ClientID != created.YourClientID || rolled.Notation != "4d6kh3" || len(rolled.Dice) != 4 || len(rolled.Dropped) != 1 {
+			t.Errorf("Unexpected dice_rolled: %+v", rolled)
+		}
+
+		player.SendMessage("get_dice_history", map[string]interface{}{})
+		resp, err = player.ReceiveMessageOfType("dice_history", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive dice_history: %v", err)
+		}
+		var history DiceHistoryData
+		json.Unmarshal(resp.Data, &history)
+		if history.Total != 1 || history.Rolls[0].Total != rolled.Total {
+			t.Errorf("Expected the roll in the history, got %+v", history.DiceHistoryPage)
+		}
+	})
+
+	t.Run("InvalidNotation", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+		client.SendMessage("create_room", map[string]interface{}{})
+		client.ReceiveMessageOfType("room_created", 5*time.Second)
+
+		client.SendMessage("roll_dice", map[string]interface{}{"notation": "2d6kh9"})
+		resp, err := client.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "Dice out of range" {
+			t.Errorf("Expected 'Dice out of range', got '%s'", data.Message)
+		}
+	})
+
+	t.Run("NotInRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("roll_dice", map[string]interface{}{"notation": "1d6"})
+		resp, err := client.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "Not in a room" {
+			t.Errorf("Expected 'Not in a room', got '%s'", data.Message)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package rolldice
+
+import "turn-tracker/backend/core"
+
+// RollDiceData is the data structure for roll_dice messages
+type RollDiceData struct {
+	Notation string `json:"notation"` // Standard dice notation (e.g. 2d6, 1d20+3, 4d6kh3)
+}
+
+// GetDiceHistoryData is the data structure for get_dice_history messages
+type GetDiceHistoryData struct {
+	Offset int `json:"offset,omitempty"` // Index of the first roll to return (0 = oldest kept roll)
+	Limit  int `json:"limit,omitempty"`  // Maximum number of rolls to return (0 = default page size)
+}
+
+// DiceRolledData is the data structure for dice_rolled messages
+type DiceRolledData struct {
+	RoomID string `json:"room_id"`
+	core.DiceRoll
+}
+
+// DiceHistoryData is the data structure for dice_history messages (sent only to the requesting client)
+type DiceHistoryData struct {
+	RoomID string `json:"room_id"`
+	core.DiceHistoryPage
+}
@@ -0,0 +1,68 @@
+package roomhost
+
+import (
+	"log"
+	"time"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleKickPlayer removes a player or spectator from the host's room
+// The kicked client cannot rejoin until the room's kick ban (kick_ban_ms) runs out
+// The message router only lets the host through
+func HandleKickPlayer(hub *core.Hub, client *core.Client, targetID string) {
+	room, ok := hostRoom(hub, client)
+	if !ok {
+		return
+	}
+
+	ban := time.Duration(room.GetSettings().KickBanMs) * time.Millisecond
+	target, bannedUntil, err := room.Kick(targetID, ban)
+	if err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRejected, err.Error())
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Announce before removing, so the kicked client learns why it is leaving
+	playerKickedMsg, err := NewPlayerKickedMessage(room.ID, targetID, client.ClientID, bannedUntil)
+	if err != nil {
+		log.Printf("Error creating player_kicked message: %v", err)
+	} else {
+		hub.BroadcastToRoom(room.ID, client.WithRequestID(playerKickedMsg))
+	}
+
+	// Remove client from room using centralized helper
+	// This handles turn cleanup and notifications automatically
+	hub.RemoveClientFromRoom(room.ID, targetID, "kicked by host")
+	roomID := room.ID
+	target.Queue(func(c *core.Client) {
+		if c.RoomID == roomID {
+			c.RoomID = ""
+			c.Spectator = false
+		}
+	})
+
+	log.Printf("Client %s kicked from room %s by host %s", targetID, room.ID, client.ClientID)
+}
+
+// hostRoom returns the client's room
+// Sends an error to the client and returns false if the client is not in a room
+func hostRoom(hub *core.Hub, client *core.Client) (*core.Room, bool) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return nil, false
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return nil, false
+	}
+
+	return room, true
+}
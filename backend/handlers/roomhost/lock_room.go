@@ -0,0 +1,27 @@
+package roomhost
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+)
+
+// HandleLockRoom locks or unlocks the host's room
+// A locked room turns away newcomers but still lets former players back in
+// The message router only lets the host through
+func HandleLockRoom(hub *core.Hub, client *core.Client, locked bool) {
+	room, ok := hostRoom(hub, client)
+	if !ok {
+		return
+	}
+
+	room.SetLocked(locked)
+
+	roomLockChangedMsg, err := NewRoomLockChangedMessage(room.ID, locked, client.ClientID)
+	if err != nil {
+		log.Printf("Error creating room_lock_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(roomLockChangedMsg))
+
+	log.Printf("Room %s locked=%v by host %s", room.ID, locked, client.ClientID)
+}
@@ -0,0 +1,82 @@
+package roomhost
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewPlayerKickedMessage creates a player_kicked message
+func NewPlayerKickedMessage(roomID, clientID, kickedBy string, bannedUntil int64) ([]byte, error) {
+	data := PlayerKickedData{
+		RoomID:      roomID,
+		ClientID:    clientID,
+		KickedBy:    kickedBy,
+		BannedUntil: bannedUntil,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "player_kicked",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewHostChangedMessage creates a host_changed message
+// changedBy is empty when the server promoted the new host
+func NewHostChangedMessage(roomID, host, previousHost, changedBy string) ([]byte, error) {
+	data := HostChangedData{
+		RoomID:       roomID,
+		Host:         host,
+		PreviousHost: previousHost,
+		ChangedBy:    changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "host_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewRoomLockChangedMessage creates a room_lock_changed message
+func NewRoomLockChangedMessage(roomID string, locked bool, changedBy string) ([]byte, error) {
+	data := RoomLockChangedData{
+		RoomID:    roomID,
+		Locked:    locked,
+		ChangedBy: changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "room_lock_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
@@ -0,0 +1,136 @@
+package roomhost
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "kick_player":
+			var data KickPlayerData
+			json.Unmarshal(msg.Data, &data)
+			HandleKickPlayer(hub, client, data.ClientID)
+		case "transfer_host":
+			var data TransferHostData
+			json.Unmarshal(msg.Data, &data)
+			HandleTransferHost(hub, client, data.ClientID)
+		case "lock_room":
+			var data LockRoomData
+			json.Unmarshal(msg.Data, &data)
+			HandleLockRoom(hub, client, data.Locked)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// TestRoomHost wraps all host command tests
+// This allows running all tests together or individually in the IDE
+func TestRoomHost(t *testing.T) {
+	t.Run("KickPlayer", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player, roomID, playerID := room.Clients[0], room.Clients[1], room.ID, room.ClientIDs[1]
+
+		host.SendMessage("kick_player", map[string]interface{}{"client_id": playerID})
+		for _, c := range []*test_helpers.TestWebSocketClient{host, player} {
+			resp, err := c.ReceiveMessageOfType("player_kicked", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive player_kicked: %v", err)
+			}
+			var data PlayerKickedData
+			json.Unmarshal(resp.Data, &data)
+			if data.ClientID != playerID || data.BannedUntil <= time.Now().UnixMilli() {
+				t.Errorf("Unexpected player_kicked: %+v", data)
+			}
+		}
+		// The kicked player cannot come straight back
+		player.SendMessage("join_room", map[string]interface{}{"room_id": roomID})
+		if msg := test_helpers.ReceiveError(t, player).Message; msg != "You were kicked from this room" {
+			t.Errorf("Expected kicked error, got '%s'", msg)
+		}
+	})
+
+	t.Run("TransferHost", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player, playerID := room.Clients[0], room.Clients[1], room.ClientIDs[1]
+
+		host.SendMessage("transfer_host", map[string]interface{}{"client_id": playerID})
+		resp, err := player.ReceiveMessageOfType("host_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive host_changed: %v", err)
+		}
+		var data HostChangedData
+		json.Unmarshal(resp.Data, &data)
+		if data.Host != playerID || data.PreviousHost == "" || data.ChangedBy != data.PreviousHost {
+			t.Errorf("Unexpected host_changed: %+v", data)
+		}
+
+		host.SendMessage("transfer_host", map[string]interface{}{"client_id": "nobody"})
+		if msg := test_helpers.ReceiveError(t, host).Message; msg != "Player not found" {
+			t.Errorf("Expected 'Player not found', got '%s'", msg)
+		}
+	})
+
+	t.Run("LockRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player, roomID := room.Clients[0], room.Clients[1], room.ID
+
+		host.SendMessage("lock_room", map[string]interface{}{"locked": true})
+		resp, err := player.ReceiveMessageOfType("room_lock_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_lock_changed: %v", err)
+		}
+		var data RoomLockChangedData
+		json.Unmarshal(resp.Data, &data)
+		if !data.Locked {
+			t.Errorf("Expected room to be locked, got %+v", data)
+		}
+
+		newcomer, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect newcomer: %v", err)
+		}
+		defer newcomer.Close()
+		time.Sleep(100 * time.Millisecond)
+		newcomer.SendMessage("join_room", map[string]interface{}{"room_id": roomID})
+		if msg := test_helpers.ReceiveError(t, newcomer).Message; msg != "Room is locked" {
+			t.Errorf("Expected 'Room is locked', got '%s'", msg)
+		}
+	})
+}
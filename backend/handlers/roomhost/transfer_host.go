@@ -0,0 +1,31 @@
+package roomhost
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleTransferHost hands the host role to another player in the room
+// The message router only lets the host through
+func HandleTransferHost(hub *core.Hub, client *core.Client, targetID string) {
+	room, ok := hostRoom(hub, client)
+	if !ok {
+		return
+	}
+
+	if err := room.TransferHost(targetID); err != nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRejected, err.Error())
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	hostChangedMsg, err := NewHostChangedMessage(room.ID, targetID, client.ClientID, client.ClientID)
+	if err != nil {
+		log.Printf("Error creating host_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(hostChangedMsg))
+
+	log.Printf("Host of room %s transferred from %s to %s", room.ID, client.ClientID, targetID)
+}
@@ -0,0 +1,39 @@
+package roomhost
+
+// KickPlayerData is the data structure for kick_player messages
+type KickPlayerData struct {
+	ClientID string `json:"client_id"` // Player or spectator to remove from the room
+}
+
+// TransferHostData is the data structure for transfer_host messages
+type TransferHostData struct {
+	ClientID string `json:"client_id"` // Player who becomes the host
+}
+
+// LockRoomData is the data structure for lock_room messages
+type LockRoomData struct {
+	Locked bool `json:"locked"` // true blocks new joins, false lets them in again
+}
+
+// PlayerKickedData is the data structure for player_kicked messages
+type PlayerKickedData struct {
+	RoomID      string `json:"room_id"`
+	ClientID    string `json:"client_id"`    // Client ID that was kicked
+	KickedBy    string `json:"kicked_by"`    // Host that kicked them
+	BannedUntil int64  `json:"banned_until"` // Unix timestamp in milliseconds when they may rejoin
+}
+
+// HostChangedData is the data structure for host_changed messages
+type HostChangedData struct {
+	RoomID       string `json:"room_id"`
+	Host         string `json:"host"`                 // Client ID of the new host
+	PreviousHost string `json:"previous_host"`        // Client ID of the previous host
+	ChangedBy    string `json:"changed_by,omitempty"` // Host that handed the role on (empty if the server promoted someone)
+}
+
+// RoomLockChangedData is the data structure for room_lock_changed messages
+type RoomLockChangedData struct {
+	RoomID    string `json:"room_id"`
+	Locked    bool   `json:"locked"`
+	ChangedBy string `json:"changed_by"` // Host that locked or unlocked the room
+}
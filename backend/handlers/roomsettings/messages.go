@@ -0,0 +1,31 @@
+package roomsettings
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewRoomSettingsChangedMessage creates a room_settings_changed message
+func NewRoomSettingsChangedMessage(roomID string, settings core.RoomSettings, changedBy string) ([]byte, error) {
+	data := RoomSettingsChangedData{
+		RoomID:    roomID,
+		Settings:  settings,
+		ChangedBy: changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "room_settings_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
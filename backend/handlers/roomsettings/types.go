@@ -0,0 +1,16 @@
+package roomsettings
+
+import "turn-tracker/backend/core"
+
+// UpdateRoomSettingsData is the data structure for update_room_settings messages
+// Only the settings present are changed, the rest keep their current value
+type UpdateRoomSettingsData struct {
+	Settings core.RoomSettingsPatch `json:"settings"`
+}
+
+// RoomSettingsChangedData is the data structure for room_settings_changed messages
+type RoomSettingsChangedData struct {
+	RoomID    string            `json:"room_id"`
+	Settings  core.RoomSettings `json:"settings"`
+	ChangedBy string            `json:"changed_by"` // Client ID that changed the settings
+}
@@ -0,0 +1,54 @@
+package roomsettings
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandleUpdateRoomSettings handles changing some of a room's settings
+// Only the host may change settings, since they include the host's own policies (turn control, pausing, kick bans)
+// If a turn is active, its timers and deadline are recalculated with the new settings
+func HandleUpdateRoomSettings(hub *core.Hub, client *core.Client, patch core.RoomSettingsPatch) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	if !room.IsHost(client.ClientID) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeForbidden, "Only the host can change room settings")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	settings, err := room.UpdateSettings(patch)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeInvalidData)
+		return
+	}
+
+	settingsChangedMsg, err := NewRoomSettingsChangedMessage(client.RoomID, settings, client.ClientID)
+	if err != nil {
+		log.Printf("Error creating room_settings_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(client.RoomID, client.WithRequestID(settingsChangedMsg))
+
+	// The time limit may have changed - re-announce the active turn so its deadline is current
+	if room.GetCurrentTurn() != "" {
+		startturn.BroadcastTurnChanged(hub, room, client)
+	}
+
+	log.Printf("Room settings changed in room %s by client %s", client.RoomID, client.ClientID)
+}
@@ -0,0 +1,237 @@
+package roomsettings
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "start_turn":
+			var data startturn.StartTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid start_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "update_room_settings":
+			var data UpdateRoomSettingsData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid update_room_settings data")
+				client.Send <- errorMsg
+				return
+			}
+			HandleUpdateRoomSettings(hub, client, data.Settings)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// createRoom connects a client and creates a room, returning the client and its ID
+func createRoom(t *testing.T, server *test_helpers.TestServer) (*test_helpers.TestWebSocketClient, string) {
+	t.Helper()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	resp, err := client.ReceiveMessageOfType("room_created", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_created: %v", err)
+	}
+	var data createroom.RoomCreatedData
+	json.Unmarshal(resp.Data, &data)
+	return client, data.YourClientID
+}
+
+// TestUpdateRoomSettings wraps all update_room_settings tests
+// This allows running all tests together or individually in the IDE
+func TestUpdateRoomSettings(t *testing.T) {
+	t.Run("BroadcastsNewSettings", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, clientID := createRoom(t, server)
+		defer client.Close()
+
+		client.SendMessage("update_room_settings", map[string]interface{}{
+			"settings": map[string]interface{}{
+				"turn_time_limit_ms": 30000,
+				"turn_expiry_policy": "end",
+			},
+		})
+
+		resp, err := client.ReceiveMessageOfType("room_settings_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_settings_changed: %v", err)
+		}
+		var data RoomSettingsChangedData
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			t.Fatalf("Failed to unmarshal room_settings_changed: %v", err)
+		}
+		if data.Settings.TurnTimeLimitMs != 30000 || data.Settings.TurnExpiryPolicy != "end" {
+			t.Errorf("Expected updated settings, got %+v", data.Settings)
+		}
+		if data.ChangedBy != clientID {
+			t.Errorf("Expected ChangedBy '%s', got '%s'", clientID, data.ChangedBy)
+		}
+	})
+
+	t.Run("ActiveTurnGetsDeadline", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, clientID := createRoom(t, server)
+		defer client.Close()
+
+		client.SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientID})
+		if _, err := client.ReceiveMessageOfType("turn_changed", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+
+		client.SendMessage("update_room_settings", map[string]interface{}{
+			"settings": map[string]interface{}{"turn_time_limit_ms": 30000},
+		})
+
+		resp, err := client.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+		var data startturn.TurnChangedData
+		json.Unmarshal(resp.Data, &data)
+		if data.TurnStartTime == nil || data.TurnDeadline == nil {
+			t.Fatalf("Expected turn start and deadline, got %+v", data)
+		}
+		if *data.TurnDeadline-*data.TurnStartTime != 30000 {
+			t.Errorf("Expected deadline 30000ms after start, got %d", *data.TurnDeadline-*data.TurnStartTime)
+		}
+	})
+
+	t.Run("InvalidSettingsRejected", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, _ := createRoom(t, server)
+		defer client.Close()
+
+		client.SendMessage("update_room_settings", map[string]interface{}{
+			"settings": map[string]interface{}{"turn_expiry_policy": "explode"},
+		})
+
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		if resp.Type != "error" {
+			t.Errorf("Expected 'error', got '%s'", resp.Type)
+		}
+	})
+
+	t.Run("HostOnly", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		host, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer host.Close()
+		player, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer player.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		host.SendMessage("create_room", map[string]interface{}{})
+		resp, err := host.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var created createroom.RoomCreatedData
+		json.Unmarshal(resp.Data, &created)
+
+		player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID})
+		if _, err := player.ReceiveMessageOfType("room_joined", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+
+		settings := map[string]interface{}{
+			"settings": map[string]interface{}{"turn_control": core.TurnControlCurrentPlayer},
+		}
+		// Host policies other than turn control are just as off limits
+		for _, requested := range []map[string]interface{}{settings, {"settings": map[string]interface{}{"pause_host_only": false}}} {
+			player.SendMessage("update_room_settings", requested)
+			resp, err = player.ReceiveMessageOfType("error", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive error: %v", err)
+			}
+			var errData types.ErrorData
+			json.Unmarshal(resp.Data, &errData)
+			if errData.Message != "Only the host can change room settings" {
+				t.Errorf("Expected host-only error, got '%s'", errData.Message)
+			}
+		}
+
+		host.SendMessage("update_room_settings", settings)
+		resp, err = host.ReceiveMessageOfType("room_settings_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_settings_changed: %v", err)
+		}
+		var data RoomSettingsChangedData
+		json.Unmarshal(resp.Data, &data)
+		if data.Settings.TurnControl != core.TurnControlCurrentPlayer {
+			t.Errorf("Expected turn control %s, got %s", core.TurnControlCurrentPlayer, data.Settings.TurnControl)
+		}
+	})
+
+	t.Run("NotInRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("update_room_settings", map[string]interface{}{"settings": map[string]interface{}{}})
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		if resp.Type != "error" {
+			t.Errorf("Expected 'error', got '%s'", resp.Type)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package scores
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewScoreChangedMessage creates a score_changed message
+func NewScoreChangedMessage(roomID string, version uint64, entry core.ScoreEntry) ([]byte, error) {
+	data := ScoreChangedData{
+		RoomID:     roomID,
+		Version:    version,
+		ScoreEntry: entry,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "score_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewScoreLedgerMessage creates a score_ledger message
+func NewScoreLedgerMessage(roomID string, page core.ScoreLedgerPage) ([]byte, error) {
+	data := ScoreLedgerData{
+		RoomID:          roomID,
+		ScoreLedgerPage: page,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "score_ledger",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
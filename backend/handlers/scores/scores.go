@@ -0,0 +1,118 @@
+package scores
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleChangeScore handles setting or adjusting a player's score
+// Exactly one of score (absolute) and delta must be set; if clientID is empty, the sender's score changes
+func HandleChangeScore(hub *core.Hub, client *core.Client, clientID string, score, delta *int64, reason string) {
+	room := scoresRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	if (score == nil) == (delta == nil) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Set either score or delta")
+		client.SafeSend(errorMsg)
+		return
+	}
+	if clientID == "" {
+		clientID = client.ClientID
+	}
+
+	isDelta := delta != nil
+	value := score
+	if isDelta {
+		value = delta
+	}
+	entry, version, err := room.ChangeScore(clientID, *value, isDelta, reason, client.ClientID)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	broadcastScoreChanged(hub, client, room, version, entry)
+	log.Printf("Score of client %s in room %s changed by %d to %d by client %s", clientID, room.ID, entry.Delta, entry.Score, client.ClientID)
+}
+
+// HandleRevertScore handles undoing a score change from the ledger
+func HandleRevertScore(hub *core.Hub, client *core.Client, seq int) {
+	room := scoresRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	entry, version, err := room.RevertScore(seq, client.ClientID)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	broadcastScoreChanged(hub, client, room, version, entry)
+	log.Printf("Score change %d reverted in room %s by client %s", seq, room.ID, client.ClientID)
+}
+
+// HandleGetScoreLedger sends a page of the room's score ledger to the requesting client
+func HandleGetScoreLedger(hub *core.Hub, client *core.Client, offset, limit int) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	if offset < 0 || limit < 0 {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid ledger range")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	ledgerMsg, err := NewScoreLedgerMessage(room.ID, room.GetScoreLedger(offset, limit))
+	if err != nil {
+		log.Printf("Error creating score_ledger message: %v", err)
+		return
+	}
+	client.SafeSend(client.WithRequestID(ledgerMsg))
+}
+
+// scoresRoom returns the room whose scores the client wants to change
+// Sends an error to the client and returns nil if the scores cannot be changed
+func scoresRoom(hub *core.Hub, client *core.Client) *core.Room {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	return room
+}
+
+// broadcastScoreChanged sends a score change to everyone in the room
+func broadcastScoreChanged(hub *core.Hub, client *core.Client, room *core.Room, version uint64, entry core.ScoreEntry) {
+	scoreChangedMsg, err := NewScoreChangedMessage(room.ID, version, entry)
+	if err != nil {
+		log.Printf("Error creating score_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(scoreChangedMsg))
+}
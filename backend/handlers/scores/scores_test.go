@@ -0,0 +1,136 @@
+package scores
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "change_score":
+			var data ChangeScoreData
+			json.Unmarshal(msg.Data, &data)
+			HandleChangeScore(hub, client, data.ClientID, data.Score, data.Delta, data.Reason)
+		case "revert_score":
+			var data RevertScoreData
+			json.Unmarshal(msg.Data, &data)
+			HandleRevertScore(hub, client, data.Seq)
+		case "get_score_ledger":
+			var data GetScoreLedgerData
+			json.Unmarshal(msg.Data, &data)
+			HandleGetScoreLedger(hub, client, data.Offset, data.Limit)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// receiveScoreChanged waits for a score_changed message
+func receiveScoreChanged(t *testing.T, client *test_helpers.TestWebSocketClient) ScoreChangedData {
+	t.Helper()
+	resp, err := client.ReceiveMessageOfType("score_changed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive score_changed: %v", err)
+	}
+	var data ScoreChangedData
+	json.Unmarshal(resp.Data, &data)
+	return data
+}
+
+// TestScores wraps all score tests
+// This allows running all tests together or individually in the IDE
+func TestScores(t *testing.T) {
+	t.Run("ChangeScoreBroadcasts", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, player, playerID := room.Clients[0], room.Clients[1], room.ClientIDs[1]
+
+		host.SendMessage("change_score", map[string]interface{}{"client_id": playerID, "delta": 10, "reason": "Longest road"})
+		changed := receiveScoreChanged(t, player)
+		if changed.ClientID != playerID || changed.Score != 10 || changed.Reason != "Longest road" || changed.Version != 1 {
+			t.Errorf("Unexpected score_changed: %+v", changed)
+		}
+
+		// Without a client_id the sender changes their own score; absolute scores are recorded as the difference
+		player.SendMessage("change_score", map[string]interface{}{"score": 4})
+		changed = receiveScoreChanged(t, host)
+		changed = receiveScoreChanged(t, host)
+		if changed.ClientID != playerID || changed.Delta != -6 || changed.Score != 4 || changed.Version != 2 {
+			t.Errorf("Expected the player's score set to 4, got %+v", changed)
+		}
+	})
+
+	t.Run("RevertScore", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host, playerID := room.Clients[0], room.ClientIDs[1]
+
+		host.SendMessage("change_score", map[string]interface{}{"client_id": playerID, "delta": 100})
+		mistake := receiveScoreChanged(t, host)
+
+		host.SendMessage("revert_score", map[string]interface{}{"seq": mistake.Seq})
+		reverted := receiveScoreChanged(t, host)
+		if reverted.Score != 0 || reverted.RevertOf == nil || *reverted.RevertOf != mistake.Seq {
+			t.Errorf("Expected the change reverted, got %+v", reverted)
+		}
+
+		host.SendMessage("get_score_ledger", map[string]interface{}{})
+		resp, err := host.ReceiveMessageOfType("score_ledger", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive score_ledger: %v", err)
+		}
+		var ledger ScoreLedgerData
+		json.Unmarshal(resp.Data, &ledger)
+		if ledger.Total != 2 || !ledger.Entries[0].Reverted {
+			t.Errorf("Expected two entries with the first reverted, got %+v", ledger.ScoreLedgerPage)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		host := room.Clients[0]
+
+		host.SendMessage("change_score", map[string]interface{}{"score": 1, "delta": 1})
+		if msg := test_helpers.ReceiveError(t, host).Message; msg != "Set either score or delta" {
+			t.Errorf("Expected score or delta error, got '%s'", msg)
+		}
+
+		host.SendMessage("change_score", map[string]interface{}{"client_id": "nobody", "delta": 1})
+		if msg := test_helpers.ReceiveError(t, host).Message; msg != "Player not found" {
+			t.Errorf("Expected player not found, got '%s'", msg)
+		}
+	})
+}
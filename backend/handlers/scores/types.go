@@ -0,0 +1,36 @@
+package scores
+
+import "turn-tracker/backend/core"
+
+// ChangeScoreData is the data structure for change_score messages
+// Exactly one of Score and Delta must be set
+type ChangeScoreData struct {
+	ClientID string `json:"client_id,omitempty"` // Player whose score changes (defaults to the sender)
+	Score    *int64 `json:"score,omitempty"`     // New absolute score
+	Delta    *int64 `json:"delta,omitempty"`     // Amount to add to the score (negative to subtract)
+	Reason   string `json:"reason,omitempty"`    // Optional note kept in the score ledger
+}
+
+// RevertScoreData is the data structure for revert_score messages
+type RevertScoreData struct {
+	Seq int `json:"seq"` // Ledger entry to revert
+}
+
+// GetScoreLedgerData is the data structure for get_score_ledger messages
+type GetScoreLedgerData struct {
+	Offset int `json:"offset,omitempty"` // Index of the first entry to return (0 = oldest kept entry)
+	Limit  int `json:"limit,omitempty"`  // Maximum number of entries to return (0 = default page size)
+}
+
+// ScoreChangedData is the data structure for score_changed messages
+type ScoreChangedData struct {
+	RoomID  string `json:"room_id"`
+	Version uint64 `json:"version"` // Score version after the change (higher = newer)
+	core.ScoreEntry
+}
+
+// ScoreLedgerData is the data structure for score_ledger messages (sent only to the requesting client)
+type ScoreLedgerData struct {
+	RoomID string `json:"room_id"`
+	core.ScoreLedgerPage
+}
@@ -0,0 +1,31 @@
+package setturnorder
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewTurnOrderChangedMessage creates a turn_order_changed message
+func NewTurnOrderChangedMessage(roomID string, order []string, changedBy string) ([]byte, error) {
+	data := TurnOrderChangedData{
+		RoomID:    roomID,
+		Order:     order,
+		ChangedBy: changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "turn_order_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
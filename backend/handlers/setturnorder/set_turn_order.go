@@ -0,0 +1,41 @@
+package setturnorder
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// HandleSetTurnOrder handles reordering the seats in a room
+// The order must list every client in the room exactly once
+func HandleSetTurnOrder(hub *core.Hub, client *core.Client, order []string) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// The room refuses the new order once the game is over, or if the turn control policy forbids it
+	if err := room.SetSeatOrder(order, client.ClientID); err != nil {
+		client.SendRoomError(err, types.ErrorCodeInvalidData)
+		return
+	}
+
+	turnOrderChangedMsg, err := NewTurnOrderChangedMessage(client.RoomID, room.GetSeatOrder(), client.ClientID)
+	if err != nil {
+		log.Printf("Error creating turn_order_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(client.RoomID, client.WithRequestID(turnOrderChangedMsg))
+
+	log.Printf("Turn order changed in room %s by client %s", client.RoomID, client.ClientID)
+}
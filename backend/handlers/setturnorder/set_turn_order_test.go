@@ -0,0 +1,204 @@
+package setturnorder
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "set_turn_order":
+			var data SetTurnOrderData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid set_turn_order data")
+				client.Send <- errorMsg
+				return
+			}
+			HandleSetTurnOrder(hub, client, data.Order)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// setupTwoPlayerRoom creates a room with two players and returns them with their client IDs
+func setupTwoPlayerRoom(t *testing.T, server *test_helpers.TestServer) (*test_helpers.TestWebSocketClient, *test_helpers.TestWebSocketClient, string, string) {
+	t.Helper()
+
+	client1, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect client1: %v", err)
+	}
+	client2, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect client2: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	client1.SendMessage("create_room", map[string]interface{}{})
+	createResp, err := client1.ReceiveMessageOfType("room_created", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_created: %v", err)
+	}
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	client2.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID})
+	joinResp, err := client2.ReceiveMessageOfType("room_joined", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_joined: %v", err)
+	}
+	var joinData joinroom.RoomJoinedData
+	json.Unmarshal(joinResp.Data, &joinData)
+
+	return client1, client2, createData.YourClientID, joinData.YourClientID
+}
+
+// TestSetTurnOrder wraps all set_turn_order tests
+// This allows running all tests together or individually in the IDE
+func TestSetTurnOrder(t *testing.T) {
+	t.Run("BroadcastsNewOrder", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client1, client2, client1ID, client2ID := setupTwoPlayerRoom(t, server)
+		defer client1.Close()
+		defer client2.Close()
+
+		client2.SendMessage("set_turn_order", map[string]interface{}{
+			"order": []string{client2ID, client1ID},
+		})
+
+		for _, c := range []*test_helpers.TestWebSocketClient{client1, client2} {
+			resp, err := c.ReceiveMessageOfType("turn_order_changed", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive turn_order_changed: %v", err)
+			}
+			var data TurnOrderChangedData
+			if err := json.Unmarshal(resp.Data, &data); err != nil {
+				t.Fatalf("Failed to unmarshal turn_order_changed: %v", err)
+			}
+			if len(data.Order) != 2 || data.Order[0] != client2ID || data.Order[1] != client1ID {
+				t.Errorf("Expected order [%s %s], got %v", client2ID, client1ID, data.Order)
+			}
+			if data.ChangedBy != client2ID {
+				t.Errorf("Expected ChangedBy '%s', got '%s'", client2ID, data.ChangedBy)
+			}
+		}
+	})
+
+	t.Run("RejectsIncompleteOrder", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client1, client2, client1ID, _ := setupTwoPlayerRoom(t, server)
+		defer client1.Close()
+		defer client2.Close()
+
+		client1.SendMessage("set_turn_order", map[string]interface{}{
+			"order": []string{client1ID},
+		})
+
+		resp, err := client1.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var errData types.ErrorData
+		json.Unmarshal(resp.Data, &errData)
+		if !strings.Contains(errData.Message, "exactly once") {
+			t.Errorf("Expected turn order error, got '%s'", errData.Message)
+		}
+	})
+
+	t.Run("FollowsTurnControl", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		host, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer host.Close()
+		player, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer player.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		host.SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{"turn_control": core.TurnControlHost},
+		})
+		createResp, err := host.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var createData createroom.RoomCreatedData
+		json.Unmarshal(createResp.Data, &createData)
+
+		player.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID})
+		joinResp, err := player.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joinData joinroom.RoomJoinedData
+		json.Unmarshal(joinResp.Data, &joinData)
+
+		player.SendMessage("set_turn_order", map[string]interface{}{
+			"order": []string{joinData.YourClientID, createData.YourClientID},
+		})
+		resp, err := player.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var errData types.ErrorData
+		json.Unmarshal(resp.Data, &errData)
+		if errData.Code != types.ErrorCodeTurnControl {
+			t.Errorf("Expected code %s, got %+v", types.ErrorCodeTurnControl, errData)
+		}
+	})
+
+	t.Run("NotInRoom", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("set_turn_order", map[string]interface{}{"order": []string{}})
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		if resp.Type != "error" {
+			t.Errorf("Expected 'error', got '%s'", resp.Type)
+		}
+	})
+}
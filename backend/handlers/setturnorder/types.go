@@ -0,0 +1,13 @@
+package setturnorder
+
+// SetTurnOrderData is the data structure for set_turn_order messages
+type SetTurnOrderData struct {
+	Order []string `json:"order"` // Every client ID in the room, in the new seating order
+}
+
+// TurnOrderChangedData is the data structure for turn_order_changed messages
+type TurnOrderChangedData struct {
+	RoomID    string   `json:"room_id"`
+	Order     []string `json:"order"`      // Client IDs in seating order
+	ChangedBy string   `json:"changed_by"` // Client ID that changed the order
+}
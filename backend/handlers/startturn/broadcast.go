@@ -0,0 +1,63 @@
+package startturn
+
+import (
+	"errors"
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// BroadcastTurnChanged broadcasts the room's current turn to all players and re-arms the turn timers
+// Every turn change should be announced through here so the timers follow the active turn
+// and rounds completed by the change are announced (round_completed is sent before turn_changed)
+// origin is the client whose request changed the turn (nil for timers and disconnects)
+func BroadcastTurnChanged(hub *core.Hub, room *core.Room, origin *core.Client) {
+	hub.ScheduleTurnTimers(room)
+	BroadcastCompletedRounds(hub, room, origin)
+
+	turnChangedMsg, err := NewTurnStateMessage(room)
+	if err != nil {
+		log.Printf("Error creating turn_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, origin.WithRequestID(turnChangedMsg))
+}
+
+// SendTurnState sends the room's current turn to a single client (state sync after a rejected change)
+// The client's request is answered with a state_mismatch error
+func SendTurnState(client *core.Client, room *core.Room) {
+	turnChangedMsg, err := NewTurnStateMessage(room)
+	if err != nil {
+		log.Printf("Error creating turn_changed message: %v", err)
+		return
+	}
+	client.SafeSend(client.WithRequestID(turnChangedMsg))
+	client.RejectRequest(types.ErrorCodeStateMismatch, "Turn state has changed")
+}
+
+// RejectTurnChange answers a turn change the room refused
+// A stale view of the turn is corrected with the current state (see SendTurnState); any other
+// refusal, such as a paused or ended game or the room's turn control policy, gets a coded error
+func RejectTurnChange(client *core.Client, room *core.Room, err error, expectedCurrentTurn string) {
+	if errors.Is(err, core.ErrTurnStateMismatch) {
+		SendTurnState(client, room)
+		log.Printf("Turn state mismatch for client %s in room %s: expected %s",
+			client.ClientID, room.ID, expectedCurrentTurn)
+		return
+	}
+	client.SendRoomError(err, types.ErrorCodeRejected)
+}
+
+// BroadcastCompletedRounds announces rounds the room completed since the last announcement
+// origin is the client whose request completed them (nil if nobody asked)
+func BroadcastCompletedRounds(hub *core.Hub, room *core.Room, origin *core.Client) {
+	for _, summary := range room.TakeCompletedRounds() {
+		roundCompletedMsg, err := NewRoundCompletedMessage(room.ID, summary)
+		if err != nil {
+			log.Printf("Error creating round_completed message: %v", err)
+			continue
+		}
+		hub.BroadcastToRoom(room.ID, origin.WithRequestID(roundCompletedMsg))
+		log.Printf("Round %d completed in room %s", summary.Round, room.ID)
+	}
+}
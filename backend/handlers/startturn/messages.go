@@ -26,6 +26,40 @@ func NewTurnChangedMessage(roomID string, currentTurn core.PeerInfo, turnStartTi
 		TurnStartTime: turnStartTimePtr,
 		Sequence:      sequence,
 	}
+	return newTurnChangedMessage(data)
+}
+
+// NewTurnStateMessage creates a turn_changed message describing the room's current turn
+// Takes the next sequence number from the room
+func NewTurnStateMessage(room *core.Room) ([]byte, error) {
+	currentTurnInfo := room.GetCurrentTurnInfo()
+	turnStartTime := room.GetTurnStartTime()
+	sequence := room.GetTurnSequence()
+
+	data := TurnChangedData{
+		RoomID:   room.ID,
+		Sequence: sequence,
+		Clocks:   room.ListClocks(),
+	}
+	// Leave current turn and timing nil in JSON if no turn is active
+	if currentTurnInfo.ClientID != "" {
+		data.CurrentTurn = &currentTurnInfo
+	}
+	if currentTeam, ok := room.GetCurrentTeam(); ok {
+		data.CurrentTeam = &currentTeam
+	}
+	if turnStartTime != 0 {
+		data.TurnStartTime = &turnStartTime
+		if limitMs := room.GetSettings().TurnTimeLimitMs; limitMs > 0 {
+			deadline := turnStartTime + limitMs
+			data.TurnDeadline = &deadline
+		}
+	}
+	return newTurnChangedMessage(data)
+}
+
+// newTurnChangedMessage marshals turn_changed data into a message
+func newTurnChangedMessage(data TurnChangedData) ([]byte, error) {
 	dataJSON, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -42,3 +76,99 @@ func NewTurnChangedMessage(roomID string, currentTurn core.PeerInfo, turnStartTi
 	// Copy into pooled buffer for reuse
 	return core.CopyToPooledBuffer(marshaled), nil
 }
+
+// NewTurnWarningMessage creates a turn_warning message
+func NewTurnWarningMessage(roomID, clientID string, remainingMs int64) ([]byte, error) {
+	data := TurnWarningData{
+		RoomID:      roomID,
+		ClientID:    clientID,
+		RemainingMs: remainingMs,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "turn_warning",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewTurnExpiredMessage creates a turn_expired message
+func NewTurnExpiredMessage(roomID, clientID, policy string) ([]byte, error) {
+	data := TurnExpiredData{
+		RoomID:   roomID,
+		ClientID: clientID,
+		Policy:   policy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "turn_expired",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewClockFlaggedMessage creates a clock_flagged message
+func NewClockFlaggedMessage(roomID, clientID string) ([]byte, error) {
+	data := ClockFlaggedData{
+		RoomID:   roomID,
+		ClientID: clientID,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "clock_flagged",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
+
+// NewRoundCompletedMessage creates a round_completed message
+func NewRoundCompletedMessage(roomID string, summary core.RoundSummary) ([]byte, error) {
+	data := RoundCompletedData{
+		RoomID:    roomID,
+		Round:     summary.Round,
+		NextRound: summary.Round + 1,
+		Players:   summary.Players,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "round_completed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
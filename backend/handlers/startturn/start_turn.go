@@ -12,12 +12,13 @@ import (
 func HandleStartTurn(hub *core.Hub, client *core.Client, expectedCurrentTurn, newTurnClientID string) {
 	// If the new turn client ID is the same as the expected current turn, do nothing
 	if newTurnClientID == expectedCurrentTurn {
+		client.RejectRequest(types.ErrorCodeNoChange, "Turn is already set")
 		return
 	}
 
 	// Check if client is in a room
 	if client.RoomID == "" {
-		errorMsg, _ := types.NewErrorMessage("Not in a room")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
 		client.SafeSend(errorMsg)
 		return
 	}
@@ -25,58 +26,34 @@ func HandleStartTurn(hub *core.Hub, client *core.Client, expectedCurrentTurn, ne
 	// Get the room
 	room := hub.GetRoom(client.RoomID)
 	if room == nil {
-		errorMsg, _ := types.NewErrorMessage("Room not found")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
 		client.SafeSend(errorMsg)
 		return
 	}
 
-	// States match - process the request
+	// The room refuses the change if the game is paused or over, or the turn control policy forbids it
 	// If new_turn is empty, end the current turn
 	if newTurnClientID == "" {
-		// Clear the current turn (validates state internally)
-		room.ClearCurrentTurn()
-
-		// Get sequence number for turn_changed message
-		sequence := room.GetTurnSequence()
-
-		// Broadcast turn ended to all players in room
-		turnChangedMsg, err := NewTurnChangedMessage(client.RoomID, core.PeerInfo{}, 0, sequence)
-		if err != nil {
-			log.Printf("Error creating turn_changed message: %v", err)
+		if err := room.ClearCurrentTurn(client.ClientID); err != nil {
+			RejectTurnChange(client, room, err, expectedCurrentTurn)
 			return
 		}
-		hub.BroadcastToRoom(client.RoomID, turnChangedMsg)
+
+		// Broadcast turn ended to all players in room
+		BroadcastTurnChanged(hub, room, client)
 
 		log.Printf("Turn ended in room %s by client %s", client.RoomID, client.ClientID)
 		return
 	}
 
 	// Try to set the new turn atomically (validates state and sets in one operation)
-	if !room.SetCurrentTurn(expectedCurrentTurn, newTurnClientID) {
-		// State mismatch or client not found - send state sync with current state
-		currentTurnInfo := room.GetCurrentTurnInfo()
-		turnStartTime := room.GetTurnStartTime()
-		sequence := room.GetTurnSequence()
-		turnChangedMsg, err := NewTurnChangedMessage(client.RoomID, currentTurnInfo, turnStartTime, sequence)
-		if err == nil {
-			// Send state sync to this client only (not broadcast)
-			client.SafeSend(turnChangedMsg)
-			log.Printf("Turn state mismatch for client %s in room %s: expected %s",
-				client.ClientID, client.RoomID, expectedCurrentTurn)
-		}
+	if err := room.SetCurrentTurn(expectedCurrentTurn, newTurnClientID, client.ClientID); err != nil {
+		RejectTurnChange(client, room, err, expectedCurrentTurn)
 		return
 	}
 
-	// Successfully set the turn - get updated state and broadcast to all players in room
-	currentTurnInfo := room.GetCurrentTurnInfo()
-	turnStartTime := room.GetTurnStartTime()
-	sequence := room.GetTurnSequence()
-	turnChangedMsg, err := NewTurnChangedMessage(client.RoomID, currentTurnInfo, turnStartTime, sequence)
-	if err != nil {
-		log.Printf("Error creating turn_changed message: %v", err)
-		return
-	}
-	hub.BroadcastToRoom(client.RoomID, turnChangedMsg)
+	// Successfully set the turn - broadcast updated state to all players in room
+	BroadcastTurnChanged(hub, room, client)
 
 	log.Printf("Turn started for client %s in room %s", newTurnClientID, client.RoomID)
 }
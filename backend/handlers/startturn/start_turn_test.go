@@ -20,7 +20,7 @@ func setupTestMessageRouter() core.MessageHandler {
 		case "create_room":
 			var data createroom.CreateRoomData
 			json.Unmarshal(msg.Data, &data)
-			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 		case "join_room":
 			var data joinroom.JoinRoomData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -29,7 +29,7 @@ func setupTestMessageRouter() core.MessageHandler {
 				return
 			}
 			roomID := strings.ToUpper(data.RoomID)
-			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
 		case "update_profile":
 			var data updateprofile.UpdateProfileData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -126,11 +126,11 @@ func TestStartTurn(t *testing.T) {
 			t.Fatalf("Failed to unmarshal room_joined: %v", err)
 		}
 
-		// Find client2ID from peers (creator is last, so client2 should be first)
+		// Find client2ID from peers (peers are in seat order, so client2 should be last)
 		var client2ID string
 		if len(roomJoinedData.Peers) > 1 {
-			// Creator is last, so joiner (client2) is first
-			client2ID = roomJoinedData.Peers[0].ClientID
+			// Peers are in seat order, so joiner (client2) is last
+			client2ID = roomJoinedData.Peers[len(roomJoinedData.Peers)-1].ClientID
 		} else {
 			// Fallback: get from player_joined notification
 			playerJoined, err := client1.ReceiveMessage(5 * time.Second)
@@ -276,7 +276,7 @@ func TestStartTurn(t *testing.T) {
 			t.Fatalf("Failed to receive player_joined: %v", err)
 		}
 
-		// Extract client2ID (creator is last, so joiner is first)
+		// Extract client2ID (peers are in seat order, so joiner is last)
 		var client2ID string
 		var joinResp struct {
 			Peers []struct {
@@ -284,8 +284,8 @@ func TestStartTurn(t *testing.T) {
 			} `json:"peers"`
 		}
 		if err := json.Unmarshal(roomJoined.Data, &joinResp); err == nil && len(joinResp.Peers) > 1 {
-			// Creator is last, so joiner (client2) is first
-			client2ID = joinResp.Peers[0].ClientID
+			// Peers are in seat order, so joiner (client2) is last
+			client2ID = joinResp.Peers[len(joinResp.Peers)-1].ClientID
 		} else {
 			// Fallback: get from player_joined
 			var pj struct {
@@ -361,3 +361,148 @@ func TestStartTurn(t *testing.T) {
 		}
 	})
 }
+
+func TestStartTurnClocks(t *testing.T) {
+	t.Run("TurnChangedCarriesClocks", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{
+				"clock_bank_ms":      300000,
+				"clock_increment_ms": 5000,
+			},
+		})
+		resp, err := client.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var roomData createroom.RoomCreatedData
+		json.Unmarshal(resp.Data, &roomData)
+		if len(roomData.Clocks) != 1 || roomData.Clocks[0].RemainingMs != 300000 {
+			t.Errorf("Expected snapshot clock with full bank, got %+v", roomData.Clocks)
+		}
+
+		client.SendMessage("start_turn", map[string]interface{}{
+			"current_turn": "",
+			"new_turn":     roomData.YourClientID,
+		})
+		turnMsg, err := client.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+		var turnData TurnChangedData
+		json.Unmarshal(turnMsg.Data, &turnData)
+		if len(turnData.Clocks) != 1 {
+			t.Fatalf("Expected 1 clock, got %d", len(turnData.Clocks))
+		}
+		if turnData.Clocks[0].ClientID != roomData.YourClientID || turnData.Clocks[0].RemainingMs != 300000 {
+			t.Errorf("Expected full bank for %s, got %+v", roomData.YourClientID, turnData.Clocks[0])
+		}
+	})
+
+	t.Run("NoClocksWithoutChessClock", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect: %v", err)
+		}
+		defer client.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		client.SendMessage("create_room", map[string]interface{}{})
+		resp, _ := client.ReceiveMessageOfType("room_created", 5*time.Second)
+		var roomData createroom.RoomCreatedData
+		json.Unmarshal(resp.Data, &roomData)
+
+		client.SendMessage("start_turn", map[string]interface{}{
+			"current_turn": "",
+			"new_turn":     roomData.YourClientID,
+		})
+		turnMsg, err := client.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+		if strings.Contains(string(turnMsg.Data), "clocks") {
+			t.Errorf("Expected no clocks field, got %s", turnMsg.Data)
+		}
+	})
+}
+
+func TestStartTurnControl(t *testing.T) {
+	t.Run("PolicyRejectsWithCode", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		host, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect host: %v", err)
+		}
+		defer host.Close()
+		player, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect player: %v", err)
+		}
+		defer player.Close()
+		time.Sleep(100 * time.Millisecond)
+
+		host.SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{"turn_control": core.TurnControlHost},
+		})
+		resp, err := host.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var created createroom.RoomCreatedData
+		json.Unmarshal(resp.Data, &created)
+
+		player.SendMessage("join_room", map[string]interface{}{"room_id": created.RoomID})
+		resp, err = player.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joined joinroom.RoomJoinedData
+		json.Unmarshal(resp.Data, &joined)
+
+		player.SendMessage("start_turn", map[string]interface{}{
+			"current_turn": "",
+			"new_turn":     joined.YourClientID,
+		})
+		errMsg, err := player.ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(errMsg.Data, &data)
+		if data.Code != types.ErrorCodeTurnControl {
+			t.Errorf("Expected code %s, got '%s'", types.ErrorCodeTurnControl, data.Code)
+		}
+		if data.Message != "Only the host can change the turn" {
+			t.Errorf("Expected host-only error, got '%s'", data.Message)
+		}
+
+		// The host is still allowed
+		host.SendMessage("start_turn", map[string]interface{}{
+			"current_turn": "",
+			"new_turn":     joined.YourClientID,
+		})
+		turnMsg, err := host.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+		var turnData TurnChangedData
+		json.Unmarshal(turnMsg.Data, &turnData)
+		if turnData.CurrentTurn == nil || turnData.CurrentTurn.ClientID != joined.YourClientID {
+			t.Errorf("Expected turn for %s, got %+v", joined.YourClientID, turnData.CurrentTurn)
+		}
+	})
+}
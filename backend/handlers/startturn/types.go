@@ -10,8 +10,39 @@ type StartTurnData struct {
 
 // TurnChangedData is the data structure for turn_changed messages
 type TurnChangedData struct {
-	RoomID        string         `json:"room_id"`
-	CurrentTurn   *core.PeerInfo `json:"current_turn"`    // nil if no turn active
-	TurnStartTime *int64         `json:"turn_start_time"` // Unix timestamp in milliseconds when turn started (nil if no turn active)
-	Sequence      uint64         `json:"sequence"`        // Sequence number to identify stale messages (higher = newer)
+	RoomID        string            `json:"room_id"`
+	CurrentTurn   *core.PeerInfo    `json:"current_turn"`            // nil if no turn active
+	TurnStartTime *int64            `json:"turn_start_time"`         // Unix timestamp in milliseconds when turn started (nil if no turn active)
+	Sequence      uint64            `json:"sequence"`                // Sequence number to identify stale messages (higher = newer)
+	TurnDeadline  *int64            `json:"turn_deadline,omitempty"` // Unix timestamp in milliseconds when the turn expires (nil if no time limit)
+	Clocks        []core.ClockState `json:"clocks,omitempty"`        // Every player's chess clock in seating order (nil if no chess clock)
+	CurrentTeam   *core.TeamInfo    `json:"current_team,omitempty"`  // Team sharing the current turn (nil if the current player is not on a team)
+}
+
+// TurnWarningData is the data structure for turn_warning messages
+type TurnWarningData struct {
+	RoomID      string `json:"room_id"`
+	ClientID    string `json:"client_id"`    // Player whose turn is about to expire
+	RemainingMs int64  `json:"remaining_ms"` // Time left before the turn expires (in milliseconds)
+}
+
+// ClockFlaggedData is the data structure for clock_flagged messages
+type ClockFlaggedData struct {
+	RoomID   string `json:"room_id"`
+	ClientID string `json:"client_id"` // Player whose time bank ran out
+}
+
+// TurnExpiredData is the data structure for turn_expired messages
+type TurnExpiredData struct {
+	RoomID   string `json:"room_id"`
+	ClientID string `json:"client_id"` // Player whose turn expired
+	Policy   string `json:"policy"`    // Expiry policy that was applied (flag, end or advance)
+}
+
+// RoundCompletedData is the data structure for round_completed messages
+type RoundCompletedData struct {
+	RoomID    string                  `json:"room_id"`
+	Round     int                     `json:"round"`      // Round that just completed
+	NextRound int                     `json:"next_round"` // Round that play continues in
+	Players   []core.RoundPlayerStats `json:"players"`    // Turn counts and time used per player in the completed round
 }
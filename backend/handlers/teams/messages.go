@@ -0,0 +1,34 @@
+package teams
+
+import (
+	"encoding/json"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/types"
+)
+
+// NewTeamsChangedMessage creates a teams_changed message
+func NewTeamsChangedMessage(roomID string, teams []core.TeamInfo, changedBy string) ([]byte, error) {
+	if teams == nil {
+		teams = []core.TeamInfo{} // Send an empty list rather than null once the last team is removed
+	}
+	data := TeamsChangedData{
+		RoomID:    roomID,
+		Teams:     teams,
+		ChangedBy: changedBy,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.Message{
+		Type: "teams_changed",
+		Data: dataJSON,
+	}
+	// Marshal message - json.Marshal allocates its own buffer
+	marshaled, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Copy into pooled buffer for reuse
+	return core.CopyToPooledBuffer(marshaled), nil
+}
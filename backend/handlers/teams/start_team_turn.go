@@ -0,0 +1,39 @@
+package teams
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandleStartTeamTurn handles giving the turn to a team
+// The team's members take the team's turns in seating order, and every member shares the turn
+// Uses optimistic concurrency like start_turn: client sends their view of current turn, server validates
+func HandleStartTeamTurn(hub *core.Hub, client *core.Client, expectedCurrentTurn, teamID string) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Try to start the team's turn atomically (validates state and sets in one operation)
+	clientID, err := room.StartTeamTurn(expectedCurrentTurn, teamID, client.ClientID)
+	if err != nil {
+		startturn.RejectTurnChange(client, room, err, expectedCurrentTurn)
+		return
+	}
+
+	startturn.BroadcastTurnChanged(hub, room, client)
+
+	log.Printf("Team %s turn started for client %s in room %s", teamID, clientID, client.RoomID)
+}
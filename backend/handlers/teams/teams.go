@@ -0,0 +1,110 @@
+package teams
+
+import (
+	"log"
+	"strings"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/helpers"
+	"turn-tracker/backend/types"
+)
+
+// HandleCreateTeam handles adding a team to the room
+// If color is empty, a random color is picked
+func HandleCreateTeam(hub *core.Hub, client *core.Client, name, color string) {
+	room := teamsRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	name = strings.TrimSpace(name)
+	if !helpers.IsValidDisplayName(name) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid team name")
+		client.SafeSend(errorMsg)
+		return
+	}
+	if color == "" {
+		color = core.GenerateRandomColor()
+	}
+	color = strings.ToUpper(strings.TrimSpace(color))
+	if !helpers.IsValidHexColor(color) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid color format (expected #RRGGBB)")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	team, err := room.CreateTeam(name, color)
+	if err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	broadcastTeamsChanged(hub, room, client)
+	log.Printf("Team %s (%s) created in room %s by client %s", team.TeamID, team.Name, room.ID, client.ClientID)
+}
+
+// HandleRemoveTeam handles deleting a team (its members stay in the room without a team)
+func HandleRemoveTeam(hub *core.Hub, client *core.Client, teamID string) {
+	room := teamsRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	if err := room.RemoveTeam(teamID); err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	broadcastTeamsChanged(hub, room, client)
+	log.Printf("Team %s removed from room %s by client %s", teamID, room.ID, client.ClientID)
+}
+
+// HandleAssignTeam handles moving a player onto a team, or off their team if teamID is empty
+// If clientID is empty, the sender is moved
+func HandleAssignTeam(hub *core.Hub, client *core.Client, clientID, teamID string) {
+	room := teamsRoom(hub, client)
+	if room == nil {
+		return
+	}
+
+	if clientID == "" {
+		clientID = client.ClientID
+	}
+	if err := room.AssignTeam(clientID, teamID); err != nil {
+		client.SendRoomError(err, types.ErrorCodeRejected)
+		return
+	}
+
+	broadcastTeamsChanged(hub, room, client)
+	log.Printf("Client %s assigned to team %q in room %s by client %s", clientID, teamID, room.ID, client.ClientID)
+}
+
+// teamsRoom returns the room whose teams the client wants to change
+// Sends an error to the client and returns nil if the teams cannot be changed
+func teamsRoom(hub *core.Hub, client *core.Client) *core.Room {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return nil
+	}
+
+	return room
+}
+
+// broadcastTeamsChanged sends every team to everyone in the room
+func broadcastTeamsChanged(hub *core.Hub, room *core.Room, client *core.Client) {
+	teamsChangedMsg, err := NewTeamsChangedMessage(room.ID, room.ListTeams(), client.ClientID)
+	if err != nil {
+		log.Printf("Error creating teams_changed message: %v", err)
+		return
+	}
+	hub.BroadcastToRoom(room.ID, client.WithRequestID(teamsChangedMsg))
+}
@@ -0,0 +1,196 @@
+package teams
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "create_team":
+			var data CreateTeamData
+			json.Unmarshal(msg.Data, &data)
+			HandleCreateTeam(hub, client, data.Name, data.Color)
+		case "remove_team":
+			var data RemoveTeamData
+			json.Unmarshal(msg.Data, &data)
+			HandleRemoveTeam(hub, client, data.TeamID)
+		case "assign_team":
+			var data AssignTeamData
+			json.Unmarshal(msg.Data, &data)
+			HandleAssignTeam(hub, client, data.ClientID, data.TeamID)
+		case "start_team_turn":
+			var data StartTeamTurnData
+			json.Unmarshal(msg.Data, &data)
+			HandleStartTeamTurn(hub, client, data.CurrentTurn, data.TeamID)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// receiveTeamsChanged waits for a teams_changed message
+func receiveTeamsChanged(t *testing.T, client *test_helpers.TestWebSocketClient) TeamsChangedData {
+	t.Helper()
+	resp, err := client.ReceiveMessageOfType("teams_changed", 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive teams_changed: %v", err)
+	}
+	var data TeamsChangedData
+	json.Unmarshal(resp.Data, &data)
+	return data
+}
+
+// TestTeams wraps all team tests
+// This allows running all tests together or individually in the IDE
+func TestTeams(t *testing.T) {
+	t.Run("CreateAndAssign", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("create_team", map[string]interface{}{"name": "North", "color": "#ff0000"})
+		created := receiveTeamsChanged(t, clients[1])
+		if len(created.Teams) != 1 || created.Teams[0].Name != "North" || created.Teams[0].Color != "#FF0000" {
+			t.Fatalf("Expected team North, got %+v", created.Teams)
+		}
+		teamID := created.Teams[0].TeamID
+		receiveTeamsChanged(t, clients[0])
+
+		// Without a client ID the sender joins the team
+		clients[1].SendMessage("assign_team", map[string]interface{}{"team_id": teamID})
+		assigned := receiveTeamsChanged(t, clients[0])
+		if members := assigned.Teams[0].Members; len(members) != 1 || members[0] != clientIDs[1] {
+			t.Errorf("Expected %s on the team, got %v", clientIDs[1], members)
+		}
+		if assigned.ChangedBy != clientIDs[1] {
+			t.Errorf("Expected ChangedBy %s, got %s", clientIDs[1], assigned.ChangedBy)
+		}
+	})
+
+	t.Run("InvalidTeamName", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, nil)
+		defer room.Close()
+		clients := room.Clients
+		defer clients[0].Close()
+
+		clients[0].SendMessage("create_team", map[string]interface{}{"name": "  "})
+		resp, err := clients[0].ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "Invalid team name" {
+			t.Errorf("Expected invalid team name error, got '%s'", data.Message)
+		}
+	})
+
+	t.Run("StartTeamTurnRotates", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 3, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("create_team", map[string]interface{}{"name": "Partners"})
+		teamID := receiveTeamsChanged(t, clients[0]).Teams[0].TeamID
+		for _, id := range []string{clientIDs[0], clientIDs[2]} {
+			clients[0].SendMessage("assign_team", map[string]interface{}{"client_id": id, "team_id": teamID})
+			receiveTeamsChanged(t, clients[0])
+		}
+
+		// The team's members take its turns in seating order
+		current := ""
+		for _, want := range []string{clientIDs[0], clientIDs[2], clientIDs[0]} {
+			clients[1].SendMessage("start_team_turn", map[string]interface{}{"current_turn": current, "team_id": teamID})
+			turn := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+			if turn.CurrentTurn == nil || turn.CurrentTurn.ClientID != want {
+				t.Fatalf("Expected turn for %s, got %+v", want, turn.CurrentTurn)
+			}
+			if turn.CurrentTeam == nil || turn.CurrentTeam.TeamID != teamID {
+				t.Errorf("Expected active team %s, got %+v", teamID, turn.CurrentTeam)
+			}
+			current = want
+		}
+	})
+
+	t.Run("StartTeamTurnEmptyTeam", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, nil)
+		defer room.Close()
+		clients := room.Clients
+		defer clients[0].Close()
+
+		clients[0].SendMessage("create_team", map[string]interface{}{"name": "Empty"})
+		teamID := receiveTeamsChanged(t, clients[0]).Teams[0].TeamID
+
+		clients[0].SendMessage("start_team_turn", map[string]interface{}{"current_turn": "", "team_id": teamID})
+		resp, err := clients[0].ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "Team has no players" {
+			t.Errorf("Expected empty team error, got '%s'", data.Message)
+		}
+	})
+
+	t.Run("RemoveTeam", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 1, nil)
+		defer room.Close()
+		clients := room.Clients
+		defer clients[0].Close()
+
+		clients[0].SendMessage("create_team", map[string]interface{}{"name": "Gone"})
+		teamID := receiveTeamsChanged(t, clients[0]).Teams[0].TeamID
+
+		clients[0].SendMessage("remove_team", map[string]interface{}{"team_id": teamID})
+		resp, err := clients[0].ReceiveMessageOfType("teams_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive teams_changed: %v", err)
+		}
+		var data map[string]interface{}
+		json.Unmarshal(resp.Data, &data)
+		if teams, ok := data["teams"].([]interface{}); !ok || len(teams) != 0 {
+			t.Errorf("Expected an empty team list, got %v", data["teams"])
+		}
+	})
+}
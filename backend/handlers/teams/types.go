@@ -0,0 +1,33 @@
+package teams
+
+import "turn-tracker/backend/core"
+
+// CreateTeamData is the data structure for create_team messages
+type CreateTeamData struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"` // Hex color code (random if omitted)
+}
+
+// RemoveTeamData is the data structure for remove_team messages
+type RemoveTeamData struct {
+	TeamID string `json:"team_id"`
+}
+
+// AssignTeamData is the data structure for assign_team messages
+type AssignTeamData struct {
+	ClientID string `json:"client_id,omitempty"` // Player to move (defaults to the sender)
+	TeamID   string `json:"team_id"`             // Team to join (empty takes the player off their team)
+}
+
+// StartTeamTurnData is the data structure for start_team_turn messages
+type StartTeamTurnData struct {
+	CurrentTurn string `json:"current_turn"` // Client's view of current turn (empty string if no turn)
+	TeamID      string `json:"team_id"`      // Team to give the turn to
+}
+
+// TeamsChangedData is the data structure for teams_changed messages
+type TeamsChangedData struct {
+	RoomID    string          `json:"room_id"`
+	Teams     []core.TeamInfo `json:"teams"`      // Every team in creation order
+	ChangedBy string          `json:"changed_by"` // Client ID that changed the teams
+}
@@ -0,0 +1,6 @@
+package undoturn
+
+// UndoTurnData is the data structure for undo_turn messages
+type UndoTurnData struct {
+	CurrentTurn string `json:"current_turn"` // Client's view of current turn (empty string if no turn)
+}
@@ -0,0 +1,38 @@
+package undoturn
+
+import (
+	"log"
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/types"
+)
+
+// HandleUndoTurn reverts the room's most recent manual turn change
+// Uses optimistic concurrency: client sends their view of current turn, so a change
+// the client has not seen yet is never undone
+func HandleUndoTurn(hub *core.Hub, client *core.Client, expectedCurrentTurn string) {
+	// Check if client is in a room
+	if client.RoomID == "" {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	// Get the room
+	room := hub.GetRoom(client.RoomID)
+	if room == nil {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
+		client.SafeSend(errorMsg)
+		return
+	}
+
+	if err := room.UndoTurn(expectedCurrentTurn, client.ClientID); err != nil {
+		startturn.RejectTurnChange(client, room, err, expectedCurrentTurn)
+		return
+	}
+
+	// Broadcast the restored turn with a new sequence number
+	startturn.BroadcastTurnChanged(hub, room, client)
+
+	log.Printf("Turn change undone in room %s by client %s", client.RoomID, client.ClientID)
+}
@@ -0,0 +1,132 @@
+package undoturn
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/test_helpers"
+	"turn-tracker/backend/types"
+)
+
+func setupTestMessageRouter() core.MessageHandler {
+	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
+		switch msg.Type {
+		case "create_room":
+			var data createroom.CreateRoomData
+			json.Unmarshal(msg.Data, &data)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
+		case "join_room":
+			var data joinroom.JoinRoomData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid join_room data")
+				client.Send <- errorMsg
+				return
+			}
+			roomID := strings.ToUpper(data.RoomID)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+		case "start_turn":
+			var data startturn.StartTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid start_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "undo_turn":
+			var data UndoTurnData
+			if err := json.Unmarshal(msg.Data, &data); err != nil {
+				errorMsg, _ := types.NewErrorMessage("Invalid undo_turn data")
+				client.Send <- errorMsg
+				return
+			}
+			HandleUndoTurn(hub, client, data.CurrentTurn)
+		default:
+			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
+			client.Send <- errorMsg
+		}
+	}
+}
+
+// TestUndoTurn wraps all undo_turn tests
+// This allows running all tests together or individually in the IDE
+func TestUndoTurn(t *testing.T) {
+	t.Run("UndoesMisTap", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, map[string]interface{}{"undo_depth": 3})
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		first := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": clientIDs[0], "new_turn": clientIDs[1]})
+		mistap := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[0], "turn_changed")
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[0], "turn_changed")
+
+		clients[1].SendMessage("undo_turn", map[string]interface{}{"current_turn": clientIDs[1]})
+		for _, c := range clients {
+			undone := test_helpers.ReceiveData[startturn.TurnChangedData](t, c, "turn_changed")
+			if undone.CurrentTurn == nil || undone.CurrentTurn.ClientID != clientIDs[0] {
+				t.Fatalf("Expected turn restored to %s, got %+v", clientIDs[0], undone.CurrentTurn)
+			}
+			if *undone.TurnStartTime != *first.TurnStartTime {
+				t.Errorf("Expected original start time %d, got %d", *first.TurnStartTime, *undone.TurnStartTime)
+			}
+			if undone.CurrentTurn.TotalTurnTime != 0 {
+				t.Errorf("Expected credited time to be given back, got %d", undone.CurrentTurn.TotalTurnTime)
+			}
+			if undone.Sequence <= mistap.Sequence {
+				t.Errorf("Expected a newer sequence than %d, got %d", mistap.Sequence, undone.Sequence)
+			}
+		}
+	})
+
+	t.Run("StateMismatchSendsSync", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, map[string]interface{}{"undo_depth": 3})
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+
+		clients[1].SendMessage("undo_turn", map[string]interface{}{"current_turn": ""})
+		sync := test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[1], "turn_changed")
+		if sync.CurrentTurn == nil || sync.CurrentTurn.ClientID != clientIDs[0] {
+			t.Errorf("Expected state sync with turn for %s, got %+v", clientIDs[0], sync.CurrentTurn)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		server := test_helpers.SetupTestServer(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		room := test_helpers.SetupRoom(t, server, 2, nil)
+		defer room.Close()
+		clients, clientIDs := room.Clients, room.ClientIDs
+
+		clients[0].SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": clientIDs[0]})
+		test_helpers.ReceiveData[startturn.TurnChangedData](t, clients[0], "turn_changed")
+
+		clients[0].SendMessage("undo_turn", map[string]interface{}{"current_turn": clientIDs[0]})
+		resp, err := clients[0].ReceiveMessageOfType("error", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error: %v", err)
+		}
+		var data types.ErrorData
+		json.Unmarshal(resp.Data, &data)
+		if data.Message != "Undo is disabled in this room" {
+			t.Errorf("Expected disabled error, got '%s'", data.Message)
+		}
+	})
+}
@@ -16,7 +16,7 @@ const (
 func HandleUpdateProfile(hub *core.Hub, client *core.Client, displayName, color string) {
 	// Check if client is in a room
 	if client.RoomID == "" {
-		errorMsg, _ := types.NewErrorMessage("Not in a room")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeNotInRoom, "Not in a room")
 		client.SafeSend(errorMsg)
 		return
 	}
@@ -31,7 +31,7 @@ func HandleUpdateProfile(hub *core.Hub, client *core.Client, displayName, color
 	if displayName != "" {
 		displayName = strings.TrimSpace(displayName)
 		if !helpers.IsValidDisplayName(displayName) {
-			errorMsg, _ := types.NewErrorMessage("Invalid display name")
+			errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid display name")
 			client.SafeSend(errorMsg)
 			return
 		}
@@ -40,7 +40,7 @@ func HandleUpdateProfile(hub *core.Hub, client *core.Client, displayName, color
 	if color != "" {
 		color = strings.ToUpper(strings.TrimSpace(color))
 		if !helpers.IsValidHexColor(color) {
-			errorMsg, _ := types.NewErrorMessage("Invalid color format (expected #RRGGBB)")
+			errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid color format (expected #RRGGBB)")
 			client.SafeSend(errorMsg)
 			return
 		}
@@ -49,7 +49,7 @@ func HandleUpdateProfile(hub *core.Hub, client *core.Client, displayName, color
 	// Get room to verify it exists
 	room := hub.GetRoom(client.RoomID)
 	if room == nil {
-		errorMsg, _ := types.NewErrorMessage("Room not found")
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeRoomNotFound, "Room not found")
 		client.SafeSend(errorMsg)
 		return
 	}
@@ -76,7 +76,7 @@ func HandleUpdateProfile(hub *core.Hub, client *core.Client, displayName, color
 		log.Printf("Error creating profile_updated message: %v", err)
 		return
 	}
-	hub.BroadcastToRoom(client.RoomID, profileUpdatedMsg)
+	hub.BroadcastToRoom(client.RoomID, client.WithRequestID(profileUpdatedMsg))
 
 	log.Printf("Client %s updated profile in room %s", client.ClientID, client.RoomID)
 }
@@ -18,7 +18,7 @@ func setupTestMessageRouter() core.MessageHandler {
 		case "create_room":
 			var data createroom.CreateRoomData
 			json.Unmarshal(msg.Data, &data)
-			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 		case "join_room":
 			var data joinroom.JoinRoomData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -26,7 +26,7 @@ func setupTestMessageRouter() core.MessageHandler {
 				client.Send <- errorMsg
 				return
 			}
-			joinroom.HandleJoinRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+			joinroom.HandleJoinRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.PIN)
 		case "update_profile":
 			var data UpdateProfileData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -13,6 +13,8 @@ import (
 
 	"turn-tracker/backend/core"
 	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/markready"
+	"turn-tracker/backend/handlers/roomhost"
 	"turn-tracker/backend/handlers/startturn"
 
 	"github.com/gorilla/websocket"
@@ -62,17 +64,19 @@ func serveWS(hub *core.Hub, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Try to get clientID from query parameter (for reconnection)
+	// Try to get clientID and its resume token from query parameters (for reconnection)
 	clientID := r.URL.Query().Get("client_id")
+	resumeToken := r.URL.Query().Get("resume_token")
 
 	// Create client without room assignment (will be assigned via create_room or join_room messages)
 	client := &core.Client{
-		Hub:      hub,
-		Conn:     conn,
-		Send:     make(chan []byte, 32), // Reduced from 256 to 8 to limit memory per client
-		ClientID: clientID,              // Will be validated/generated in hub.Register
-		RoomID:   "",                    // Will be set when room is created/joined
-		IP:       clientIP,              // Store IP for cleanup
+		Hub:         hub,
+		Conn:        conn,
+		Send:        make(chan []byte, 32), // Reduced from 256 to 8 to limit memory per client
+		ClientID:    clientID,              // Will be validated/generated in hub.Register
+		ResumeToken: resumeToken,           // Checked and replaced in hub.Register
+		RoomID:      "",                    // Will be set when room is created/joined
+		IP:          clientIP,              // Store IP for cleanup
 	}
 
 	client.Ctx, client.Cancel = context.WithCancel(context.Background())
@@ -87,9 +91,8 @@ func serveWS(hub *core.Hub, w http.ResponseWriter, r *http.Request) {
 	go client.ReadPump()
 }
 
-func main() {
-	hub := core.NewHub()
-
+// setupHubCallbacks wires the hub's event callbacks to their broadcast messages
+func setupHubCallbacks(hub *core.Hub) {
 	// Set up callback for player left notifications
 	hub.OnPlayerLeft = func(roomID, clientID string, _ []byte) {
 		playerLeftMsg, err := joinroom.NewPlayerLeftMessage(roomID, clientID)
@@ -98,20 +101,113 @@ func main() {
 		}
 	}
 
-	// Set up callback for turn ended (when player disconnects during their turn)
+	// Set up callback for a reconnecting client going back into its last room
+	hub.OnSessionResumed = func(roomID string, client *core.Client, spectator bool) {
+		joinroom.ResumeSession(hub, client, roomID, spectator)
+	}
+
+	// Set up callback for presence changed (a player's connection dropped, or they stayed away too long)
+	// Clients without the presence feature only hear about the player once they are gone, as player_left
+	hub.OnPresenceChanged = func(roomID, clientID, presence string) {
+		presenceChangedMsg, err := joinroom.NewPresenceChangedMessage(roomID, clientID, presence)
+		if err != nil {
+			return
+		}
+		var playerLeftMsg []byte
+		if presence == core.PresenceGone {
+			if playerLeftMsg, err = joinroom.NewPlayerLeftMessage(roomID, clientID); err != nil {
+				return
+			}
+		}
+		hub.BroadcastToRoomByFeature(roomID, nil, core.FeaturePresence, presenceChangedMsg, playerLeftMsg)
+	}
+
+	// Set up callback for host promoted (the host left or did not reconnect in time)
+	hub.OnHostChanged = func(roomID, hostID, previousHostID string) {
+		hostChangedMsg, err := roomhost.NewHostChangedMessage(roomID, hostID, previousHostID, "")
+		if err == nil {
+			hub.BroadcastToRoom(roomID, hostChangedMsg)
+		}
+	}
+
+	// Set up callback for spectator left (only the spectator count changes)
+	hub.OnSpectatorsChanged = func(roomID string) {
+		room := hub.GetRoom(roomID)
+		if room == nil {
+			return
+		}
+		spectatorsChangedMsg, err := joinroom.NewSpectatorsChangedMessage(roomID, room.SpectatorCount())
+		if err == nil {
+			hub.BroadcastToRoom(roomID, spectatorsChangedMsg)
+		}
+	}
+
+	// Set up callback for a waitlisted client taking a free seat
+	hub.OnWaitlistAdmitted = func(roomID string, client *core.Client) {
+		room := hub.GetRoom(roomID)
+		if room == nil {
+			return
+		}
+		joinroom.AnnounceWaitlistAdmitted(hub, room, client)
+	}
+
+	// Set up callback for waitlisted clients moving up in line
+	hub.OnWaitlistChanged = func(roomID string) {
+		room := hub.GetRoom(roomID)
+		if room == nil {
+			return
+		}
+		joinroom.SendWaitlistPositions(room)
+	}
+
+	// Set up callback for turn ended (when player disconnects during their turn or a phase waiting on them)
 	hub.OnTurnEnded = func(roomID string) {
 		room := hub.GetRoom(roomID)
 		if room == nil {
 			return
 		}
-		// Get sequence number for turn_changed message
-		sequence := room.GetTurnSequence()
-		turnChangedMsg, err := startturn.NewTurnChangedMessage(roomID, core.PeerInfo{}, 0, sequence)
+		markready.BroadcastPhaseState(hub, room)
+		startturn.BroadcastTurnChanged(hub, room, nil)
+	}
+
+	// Set up callback for turn warning (active turn is close to its time limit)
+	hub.OnTurnWarning = func(roomID, clientID string, remainingMs int64) {
+		turnWarningMsg, err := startturn.NewTurnWarningMessage(roomID, clientID, remainingMs)
 		if err == nil {
-			hub.BroadcastToRoom(roomID, turnChangedMsg)
+			hub.BroadcastToRoom(roomID, turnWarningMsg)
 		}
 	}
 
+	// Set up callback for turn expired (policy already applied by the hub)
+	hub.OnTurnExpired = func(roomID, clientID, policy string) {
+		turnExpiredMsg, err := startturn.NewTurnExpiredMessage(roomID, clientID, policy)
+		if err == nil {
+			hub.BroadcastToRoom(roomID, turnExpiredMsg)
+		}
+		if policy == core.TurnExpiryFlag {
+			return
+		}
+		room := hub.GetRoom(roomID)
+		if room == nil {
+			return
+		}
+		startturn.BroadcastTurnChanged(hub, room, nil)
+	}
+
+	// Set up callback for clock flagged (active player's time bank ran out)
+	hub.OnClockFlagged = func(roomID, clientID string) {
+		clockFlaggedMsg, err := startturn.NewClockFlaggedMessage(roomID, clientID)
+		if err == nil {
+			hub.BroadcastToRoom(roomID, clockFlaggedMsg)
+		}
+	}
+}
+
+func main() {
+	hub := core.NewHub()
+
+	setupHubCallbacks(hub)
+
 	go hub.Run()
 
 	// Start room cleanup goroutine
@@ -11,8 +11,10 @@ import (
 
 	"turn-tracker/backend/core"
 	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/hello"
 	"turn-tracker/backend/handlers/joinroom"
 	"turn-tracker/backend/handlers/leaveroom"
+	"turn-tracker/backend/handlers/markready"
 	"turn-tracker/backend/handlers/startturn"
 	"turn-tracker/backend/handlers/updateprofile"
 	"turn-tracker/backend/test_helpers"
@@ -24,10 +26,14 @@ import (
 func setupTestMessageRouter() core.MessageHandler {
 	return func(hub *core.Hub, client *core.Client, msg *types.Message) {
 		switch msg.Type {
+		case "hello":
+			var data hello.HelloData
+			json.Unmarshal(msg.Data, &data)
+			hello.HandleHello(hub, client, data.ProtocolVersion, data.Features)
 		case "create_room":
 			var data createroom.CreateRoomData
 			json.Unmarshal(msg.Data, &data)
-			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+			createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 		case "join_room":
 			var data joinroom.JoinRoomData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -36,7 +42,7 @@ func setupTestMessageRouter() core.MessageHandler {
 				return
 			}
 			roomID := strings.ToUpper(data.RoomID)
-			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color)
+			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
 		case "update_profile":
 			var data updateprofile.UpdateProfileData
 			if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -53,6 +59,10 @@ func setupTestMessageRouter() core.MessageHandler {
 				return
 			}
 			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
+		case "start_phase":
+			markready.HandleStartPhase(hub, client)
+		case "mark_ready":
+			markready.HandleMarkReady(hub, client)
 		default:
 			errorMsg, _ := types.NewUnknownMessageTypeError(msg.Type)
 			client.Send <- errorMsg
@@ -66,24 +76,7 @@ func setupTestServerWithCallbacks(router core.MessageHandler) *test_helpers.Test
 	hub := core.NewHub()
 
 	// Set up callbacks exactly like in main.go
-	hub.OnPlayerLeft = func(roomID, clientID string, _ []byte) {
-		playerLeftMsg, err := joinroom.NewPlayerLeftMessage(roomID, clientID)
-		if err == nil {
-			hub.BroadcastToRoom(roomID, playerLeftMsg)
-		}
-	}
-
-	hub.OnTurnEnded = func(roomID string) {
-		room := hub.GetRoom(roomID)
-		if room == nil {
-			return
-		}
-		sequence := room.GetTurnSequence()
-		turnChangedMsg, err := startturn.NewTurnChangedMessage(roomID, core.PeerInfo{}, 0, sequence)
-		if err == nil {
-			hub.BroadcastToRoom(roomID, turnChangedMsg)
-		}
-	}
+	setupHubCallbacks(hub)
 
 	// Create server manually since we need custom callbacks
 	go hub.Run()
@@ -108,7 +101,8 @@ func setupTestServerWithCallbacks(router core.MessageHandler) *test_helpers.Test
 			Hub:            hub,
 			Conn:           conn,
 			Send:           make(chan []byte, 32),
-			ClientID:       core.GenerateClientID(),
+			ClientID:       r.URL.Query().Get("client_id"), // Validated/generated in hub.Register, like serveWS
+			ResumeToken:    r.URL.Query().Get("resume_token"),
 			RoomID:         "",
 			MessageHandler: router,
 		}
@@ -239,9 +233,10 @@ func TestMainIntegration(t *testing.T) {
 		}
 	})
 
-	t.Run("PlayerLeftCallbackOnDisconnect", func(t *testing.T) {
+	t.Run("PresenceChangedCallbackOnDisconnect", func(t *testing.T) {
 		server := setupTestServerWithCallbacks(setupTestMessageRouter())
 		defer server.Cleanup()
+		server.Hub.SetAwayGracePeriods(time.Hour, 300*time.Millisecond)
 
 		// Connect 2 clients
 		client1, err := test_helpers.ConnectTestClient(server.Server.URL)
@@ -258,6 +253,9 @@ func TestMainIntegration(t *testing.T) {
 
 		time.Sleep(100 * time.Millisecond)
 
+		// Client1 understands presence
+		sayHello(t, client1, core.FeaturePresence)
+
 		// Client1 creates room
 		err = client1.SendMessage("create_room", map[string]interface{}{
 			"display_name": "Host",
@@ -292,31 +290,411 @@ func TestMainIntegration(t *testing.T) {
 		client2.Close()
 		time.Sleep(200 * time.Millisecond) // Allow time for disconnect processing
 
-		// Client1 should receive player_left message
-		playerLeftMsg, err := client1.ReceiveMessage(5 * time.Second)
+		// Client1 should hear that client2 is away, then gone once the grace period ends
+		for _, want := range []string{core.PresenceAway, core.PresenceGone} {
+			presenceMsg, err := client1.ReceiveMessageOfType("presence_changed", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive presence_changed (%s): %v", want, err)
+			}
+
+			var presenceData joinroom.PresenceChangedData
+			json.Unmarshal(presenceMsg.Data, &presenceData)
+
+			if presenceData.RoomID != roomID {
+				t.Errorf("Expected room_id %s, got %v", roomID, presenceData.RoomID)
+			}
+			if presenceData.PeerID == "" {
+				t.Error("presence_changed message should contain peer_id")
+			}
+			if presenceData.Presence != want {
+				t.Errorf("Expected presence %s, got %s", want, presenceData.Presence)
+			}
+		}
+	})
+
+	t.Run("PlayerLeftForLegacyClientsOnDisconnect", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(setupTestMessageRouter())
+		defer server.Cleanup()
+		server.Hub.SetAwayGracePeriods(time.Hour, 300*time.Millisecond)
+
+		client1, err := test_helpers.ConnectTestClient(server.Server.URL)
 		if err != nil {
-			t.Fatalf("Failed to receive player_left message: %v", err)
+			t.Fatalf("Failed to connect client1: %v", err)
 		}
+		defer client1.Close()
 
-		if playerLeftMsg.Type != "player_left" {
-			t.Errorf("Expected player_left message, got %s", playerLeftMsg.Type)
+		client2, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client2: %v", err)
 		}
 
-		var leftData map[string]interface{}
-		json.Unmarshal(playerLeftMsg.Data, &leftData)
+		time.Sleep(100 * time.Millisecond)
 
-		if leftData["room_id"] != roomID {
-			t.Errorf("Expected room_id %s, got %v", roomID, leftData["room_id"])
+		// Client1 never sends hello, like a PWA cached from before the handshake
+		client1.SendMessage("create_room", map[string]interface{}{})
+		createResp, err := client1.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var createData createroom.RoomCreatedData
+		json.Unmarshal(createResp.Data, &createData)
+
+		client2.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID})
+		joinResp, err := client2.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joinData joinroom.RoomJoinedData
+		json.Unmarshal(joinResp.Data, &joinData)
+		client1.ReceiveMessageOfType("player_joined", 5*time.Second)
+
+		client2.Close()
+
+		// No presence_changed - just player_left once the seat is given up
+		resp, err := client1.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive player_left: %v", err)
+		}
+		if resp.Type != "player_left" {
+			t.Fatalf("Expected player_left, got %s", resp.Type)
+		}
+		var leftData joinroom.PlayerLeftData
+		json.Unmarshal(resp.Data, &leftData)
+		if leftData.PeerID != joinData.YourClientID {
+			t.Errorf("Expected %s to leave, got %s", joinData.YourClientID, leftData.PeerID)
+		}
+	})
+
+	t.Run("SessionResumedOnReconnect", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client1, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client1: %v", err)
+		}
+		defer client1.Close()
+
+		client2, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client2: %v", err)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+
+		sayHello(t, client1, core.FeaturePresence)
+		client1.SendMessage("create_room", map[string]interface{}{"display_name": "Host"})
+		createResp, err := client1.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var createData createroom.RoomCreatedData
+		json.Unmarshal(createResp.Data, &createData)
+
+		client2.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID, "display_name": "Player"})
+		joinResp, err := client2.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joinData joinroom.RoomJoinedData
+		json.Unmarshal(joinResp.Data, &joinData)
+
+		// Client2's connection drops, and it comes back with its client ID
+		client2.Close()
+		if _, err := client1.ReceiveMessageOfType("presence_changed", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive presence_changed (away): %v", err)
+		}
+
+		returned, err := test_helpers.ConnectTestClientWithID(server.Server.URL, joinData.YourClientID, joinData.ResumeToken)
+		if err != nil {
+			t.Fatalf("Failed to reconnect client2: %v", err)
+		}
+		defer returned.Close()
+
+		// No join_room needed - the snapshot and session_resumed arrive on their own
+		rejoinResp, err := returned.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined after reconnecting: %v", err)
+		}
+		var rejoinData joinroom.RoomJoinedData
+		json.Unmarshal(rejoinResp.Data, &rejoinData)
+		if rejoinData.RoomID != createData.RoomID || len(rejoinData.Peers) != 2 {
+			t.Errorf("Expected a snapshot of room %s with 2 players, got %s with %d", createData.RoomID, rejoinData.RoomID, len(rejoinData.Peers))
+		}
+
+		resumedResp, err := returned.ReceiveMessageOfType("session_resumed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive session_resumed: %v", err)
+		}
+		var resumed joinroom.SessionResumedData
+		json.Unmarshal(resumedResp.Data, &resumed)
+		if resumed.RoomID != createData.RoomID || resumed.YourClientID != joinData.YourClientID || resumed.DisplayName != "Player" {
+			t.Errorf("Unexpected session_resumed data: %+v", resumed)
+		}
+		if rejoinData.ResumeToken == "" || rejoinData.ResumeToken == joinData.ResumeToken || resumed.ResumeToken != rejoinData.ResumeToken {
+			t.Error("Expected a new resume token after reconnecting, also in session_resumed")
+		}
+
+		presenceMsg, err := client1.ReceiveMessageOfType("presence_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive presence_changed (connected): %v", err)
+		}
+		var presence joinroom.PresenceChangedData
+		json.Unmarshal(presenceMsg.Data, &presence)
+		if presence.PeerID != joinData.YourClientID || presence.Presence != core.PresenceConnected {
+			t.Errorf("Expected %s to be connected again, got %+v", joinData.YourClientID, presence)
+		}
+	})
+
+	t.Run("ReconnectReplacesLiveConnection", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client1, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client1: %v", err)
+		}
+		defer client1.Close()
+
+		client2, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client2: %v", err)
+		}
+		defer client2.Close()
+
+		time.Sleep(100 * time.Millisecond)
+
+		client1.SendMessage("create_room", map[string]interface{}{"display_name": "Host"})
+		createResp, err := client1.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var createData createroom.RoomCreatedData
+		json.Unmarshal(createResp.Data, &createData)
+
+		client2.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID, "display_name": "Player"})
+		joinResp, err := client2.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joinData joinroom.RoomJoinedData
+		json.Unmarshal(joinResp.Data, &joinData)
+
+		// Client2 reconnects (e.g. after switching networks) before the server noticed its old connection dropped
+		returned, err := test_helpers.ConnectTestClientWithID(server.Server.URL, joinData.YourClientID, joinData.ResumeToken)
+		if err != nil {
+			t.Fatalf("Failed to reconnect client2: %v", err)
+		}
+		defer returned.Close()
+
+		resumedResp, err := returned.ReceiveMessageOfType("session_resumed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive session_resumed: %v", err)
+		}
+		var resumed joinroom.SessionResumedData
+		json.Unmarshal(resumedResp.Data, &resumed)
+		if resumed.RoomID != createData.RoomID || resumed.YourClientID != joinData.YourClientID || resumed.DisplayName != "Player" {
+			t.Errorf("Expected the new connection to keep client2's seat, got %+v", resumed)
+		}
+		if resumed.ResumeToken == "" || resumed.ResumeToken == joinData.ResumeToken {
+			t.Error("Expected a new resume token in session_resumed")
+		}
+
+		// The server closed the old connection (reading fails once any queued messages are drained)
+		for i := 0; ; i++ {
+			if _, err := client2.ReceiveMessage(2 * time.Second); err != nil {
+				break
+			}
+			if i == 10 {
+				t.Fatal("Expected the old connection to be closed")
+			}
+		}
+		room := server.Hub.GetRoom(createData.RoomID)
+		if room == nil || room.IsAway(joinData.YourClientID) || len(room.ListPeerIDs()) != 2 {
+			t.Error("Expected client2 to be seated and connected on its new connection")
+		}
+	})
+
+	t.Run("SpectatorsChangedCallbackOnDisconnect", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(messageRouter)
+		defer server.Cleanup()
+
+		host, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect host: %v", err)
+		}
+		defer host.Close()
+
+		spectator, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect spectator: %v", err)
+		}
+		defer spectator.Close()
+
+		time.Sleep(100 * time.Millisecond)
+
+		host.SendMessage("create_room", map[string]interface{}{})
+		resp, err := host.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var roomData map[string]interface{}
+		json.Unmarshal(resp.Data, &roomData)
+		roomID := roomData["room_id"].(string)
+
+		spectator.SendMessage("join_room", map[string]interface{}{
+			"room_id":   roomID,
+			"spectator": true,
+		})
+		_, _ = spectator.ReceiveMessage(2 * time.Second)
+		_, _ = host.ReceiveMessage(2 * time.Second)
+
+		// A spectator leaving only changes the count - no player_left
+		spectator.Close()
+		time.Sleep(200 * time.Millisecond)
+
+		msg, err := host.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive spectators_changed: %v", err)
+		}
+		if msg.Type != "spectators_changed" {
+			t.Fatalf("Expected spectators_changed message, got %s", msg.Type)
+		}
+		var changed map[string]interface{}
+		json.Unmarshal(msg.Data, &changed)
+		if changed["spectators"] != float64(0) {
+			t.Errorf("Expected 0 spectators, got %v", changed["spectators"])
+		}
+	})
+
+	t.Run("HostChangedCallbackOnLeaveRoom", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(messageRouter)
+		defer server.Cleanup()
+
+		host, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect host: %v", err)
+		}
+		defer host.Close()
+
+		player, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect player: %v", err)
+		}
+		defer player.Close()
+
+		time.Sleep(100 * time.Millisecond)
+
+		host.SendMessage("create_room", map[string]interface{}{})
+		resp, err := host.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var roomData map[string]interface{}
+		json.Unmarshal(resp.Data, &roomData)
+		roomID := roomData["room_id"].(string)
+		hostID := roomData["your_client_id"].(string)
+
+		player.SendMessage("join_room", map[string]interface{}{"room_id": roomID})
+		resp, err = player.ReceiveMessage(2 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joinData map[string]interface{}
+		json.Unmarshal(resp.Data, &joinData)
+		playerID := joinData["your_client_id"].(string)
+
+		// The host leaving on purpose hands the role on straight away
+		host.SendMessage("leave_room", map[string]interface{}{"room_id": roomID})
+
+		msg, err := player.ReceiveMessageOfType("host_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive host_changed: %v", err)
+		}
+		var changed map[string]interface{}
+		json.Unmarshal(msg.Data, &changed)
+		if changed["host"] != playerID || changed["previous_host"] != hostID || changed["changed_by"] != nil {
+			t.Errorf("Expected automatic promotion of %s, got %v", playerID, changed)
+		}
+	})
+
+	t.Run("WaitlistAdmittedOnLeaveRoom", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(messageRouter)
+		defer server.Cleanup()
+
+		clients := make([]*test_helpers.TestWebSocketClient, 4)
+		for i := range clients {
+			client, err := test_helpers.ConnectTestClient(server.Server.URL)
+			if err != nil {
+				t.Fatalf("Failed to connect client %d: %v", i, err)
+			}
+			defer client.Close()
+			clients[i] = client
+		}
+		time.Sleep(100 * time.Millisecond)
+
+		clients[0].SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{"capacity": 2},
+		})
+		resp, err := clients[0].ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var roomData map[string]interface{}
+		json.Unmarshal(resp.Data, &roomData)
+		roomID := roomData["room_id"].(string)
+
+		clients[1].SendMessage("join_room", map[string]interface{}{"room_id": roomID})
+		if _, err := clients[1].ReceiveMessageOfType("room_joined", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+
+		// The room is full, so the next two wait in line
+		for i, client := range clients[2:] {
+			client.SendMessage("join_room", map[string]interface{}{"room_id": roomID})
+			msg, err := client.ReceiveMessageOfType("waitlisted", 5*time.Second)
+			if err != nil {
+				t.Fatalf("Failed to receive waitlisted: %v", err)
+			}
+			var waitlisted map[string]interface{}
+			json.Unmarshal(msg.Data, &waitlisted)
+			if waitlisted["position"] != float64(i+1) {
+				t.Errorf("Expected position %d, got %v", i+1, waitlisted["position"])
+			}
 		}
 
-		if leftData["peer_id"] == nil {
-			t.Error("player_left message should contain peer_id")
+		// A player leaving seats the first in line and moves the second up
+		clients[1].SendMessage("leave_room", map[string]interface{}{"room_id": roomID})
+
+		msg, err := clients[2].ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined from the waitlist: %v", err)
+		}
+		var joinData map[string]interface{}
+		json.Unmarshal(msg.Data, &joinData)
+		if peers := joinData["peers"].([]interface{}); len(peers) != 2 {
+			t.Errorf("Expected 2 seated players, got %d", len(peers))
+		}
+
+		msg, err = clients[3].ReceiveMessageOfType("waitlisted", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive updated waitlisted: %v", err)
+		}
+		var waitlisted map[string]interface{}
+		json.Unmarshal(msg.Data, &waitlisted)
+		if waitlisted["position"] != float64(1) {
+			t.Errorf("Expected to move up to position 1, got %v", waitlisted["position"])
+		}
+
+		if _, err := clients[0].ReceiveMessageOfType("player_joined", 5*time.Second); err != nil {
+			t.Errorf("Expected the host to hear about the admitted player: %v", err)
 		}
 	})
 
 	t.Run("TurnEndedCallbackOnDisconnect", func(t *testing.T) {
 		server := setupTestServerWithCallbacks(setupTestMessageRouter())
 		defer server.Cleanup()
+		server.Hub.SetAwayGracePeriods(50*time.Millisecond, time.Hour)
 
 		// Connect 2 clients
 		client1, err := test_helpers.ConnectTestClient(server.Server.URL)
@@ -409,9 +787,9 @@ func TestMainIntegration(t *testing.T) {
 		client2.Close()
 		time.Sleep(200 * time.Millisecond) // Allow time for disconnect processing
 
-		// Client1 should receive both turn_changed AND player_left messages
-		// (order may vary, so collect both)
-		var turnChangedMsg, playerLeftMsg *types.Message
+		// Client1 should receive presence_changed (away) and then turn_changed once the turn grace ends
+		// (collect both)
+		var turnChangedMsg, presenceMsg *types.Message
 		var messagesReceived []string
 
 		// Try to receive messages until we have both or timeout
@@ -425,15 +803,15 @@ func TestMainIntegration(t *testing.T) {
 				if turnChangedMsg == nil {
 					continue
 				}
-				// Got turn_changed, try a bit more for player_left, but don't fail if we don't get it
+				// Got turn_changed, try a bit more for presence_changed, but don't fail if we don't get it
 				break
 			}
 			messagesReceived = append(messagesReceived, msg.Type)
 
 			if msg.Type == "turn_changed" && turnChangedMsg == nil {
 				turnChangedMsg = &msg
-			} else if msg.Type == "player_left" && playerLeftMsg == nil {
-				playerLeftMsg = &msg
+			} else if msg.Type == "presence_changed" && presenceMsg == nil {
+				presenceMsg = &msg
 			}
 		}
 
@@ -461,14 +839,14 @@ func TestMainIntegration(t *testing.T) {
 			}
 		}
 
-		// Note: OnPlayerLeft callback also fires, but the player_left message
-		// may arrive after turn_changed or be batched. OnPlayerLeft is tested
-		// separately in PlayerLeftCallbackOnDisconnect test.
+		// Note: OnPresenceChanged fires first, but the presence_changed message
+		// may be batched. OnPresenceChanged is tested separately in
+		// PresenceChangedCallbackOnDisconnect test.
 		// The important thing here is that OnTurnEnded fires when a client
-		// with the current turn disconnects, which we've verified above.
-		if playerLeftMsg == nil {
-			// Log but don't fail - OnPlayerLeft is tested in separate test
-			t.Logf("Note: player_left message not received (may be timing/batching issue), received: %v", messagesReceived)
+		// with the current turn stays away past the turn grace, which we've verified above.
+		if presenceMsg == nil {
+			// Log but don't fail - OnPresenceChanged is tested in separate test
+			t.Logf("Note: presence_changed message not received (may be timing/batching issue), received: %v", messagesReceived)
 		}
 	})
 
@@ -482,7 +860,7 @@ func TestMainIntegration(t *testing.T) {
 			case "create_room":
 				var data createroom.CreateRoomData
 				json.Unmarshal(msg.Data, &data)
-				createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color)
+				createroom.HandleCreateRoom(hub, client, data.RoomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 			case "join_room":
 				var data joinroom.JoinRoomData
 				if err := json.Unmarshal(msg.Data, &data); err != nil {
@@ -491,7 +869,7 @@ func TestMainIntegration(t *testing.T) {
 					return
 				}
 				roomID := strings.ToUpper(data.RoomID)
-				joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color)
+				joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
 			case "leave_room":
 				var data struct {
 					RoomID string `json:"room_id"`
@@ -578,4 +956,157 @@ func TestMainIntegration(t *testing.T) {
 			t.Errorf("Expected room_id %s, got %v", roomID, leftData["room_id"])
 		}
 	})
+
+	t.Run("TurnExpiryCallbacks", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(setupTestMessageRouter())
+		defer server.Cleanup()
+
+		client1, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client1: %v", err)
+		}
+		defer client1.Close()
+
+		client2, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client2: %v", err)
+		}
+		defer client2.Close()
+
+		time.Sleep(100 * time.Millisecond)
+
+		// Client1 creates a room with a short time limit that advances to the next seat
+		client1.SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{
+				"turn_time_limit_ms": 300,
+				"turn_warning_ms":    200,
+				"turn_expiry_policy": "advance",
+			},
+		})
+		createResp, err := client1.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var createData createroom.RoomCreatedData
+		json.Unmarshal(createResp.Data, &createData)
+		client1ID := createData.YourClientID
+
+		client2.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID})
+		joinResp, err := client2.ReceiveMessageOfType("room_joined", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+		var joinData joinroom.RoomJoinedData
+		json.Unmarshal(joinResp.Data, &joinData)
+		client2ID := joinData.YourClientID
+
+		client1.SendMessage("start_turn", map[string]interface{}{"current_turn": "", "new_turn": client1ID})
+		if _, err := client2.ReceiveMessageOfType("turn_changed", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+
+		// Server sends warning, then expiry, then the advanced turn
+		warningMsg, err := client2.ReceiveMessageOfType("turn_warning", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_warning: %v", err)
+		}
+		var warningData startturn.TurnWarningData
+		json.Unmarshal(warningMsg.Data, &warningData)
+		if warningData.ClientID != client1ID {
+			t.Errorf("Expected warning for %s, got %s", client1ID, warningData.ClientID)
+		}
+
+		expiredMsg, err := client2.ReceiveMessageOfType("turn_expired", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_expired: %v", err)
+		}
+		var expiredData startturn.TurnExpiredData
+		json.Unmarshal(expiredMsg.Data, &expiredData)
+		if expiredData.ClientID != client1ID || expiredData.Policy != "advance" {
+			t.Errorf("Expected advance expiry for %s, got %+v", client1ID, expiredData)
+		}
+
+		turnMsg, err := client2.ReceiveMessageOfType("turn_changed", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+		var turnData startturn.TurnChangedData
+		json.Unmarshal(turnMsg.Data, &turnData)
+		if turnData.CurrentTurn == nil || turnData.CurrentTurn.ClientID != client2ID {
+			t.Errorf("Expected turn to advance to %s, got %+v", client2ID, turnData.CurrentTurn)
+		}
+		if turnData.TurnDeadline == nil {
+			t.Error("Expected advanced turn to carry a deadline")
+		}
+
+		// End the turn manually so its timers are disarmed before cleanup
+		client2.SendMessage("start_turn", map[string]interface{}{"current_turn": client2ID, "new_turn": ""})
+		if _, err := client2.ReceiveMessageOfType("turn_changed", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive turn_changed: %v", err)
+		}
+	})
+
+	t.Run("PhaseEndsWhenLastWaitingPlayerLeaves", func(t *testing.T) {
+		server := setupTestServerWithCallbacks(setupTestMessageRouter())
+		defer server.Cleanup()
+		server.Hub.SetAwayGracePeriods(50*time.Millisecond, time.Hour)
+
+		client1, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client1: %v", err)
+		}
+		defer client1.Close()
+
+		client2, err := test_helpers.ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client2: %v", err)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+
+		client1.SendMessage("create_room", map[string]interface{}{
+			"settings": map[string]interface{}{"turn_mode": "simultaneous"},
+		})
+		createResp, err := client1.ReceiveMessageOfType("room_created", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive room_created: %v", err)
+		}
+		var createData createroom.RoomCreatedData
+		json.Unmarshal(createResp.Data, &createData)
+
+		client2.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID})
+		if _, err := client2.ReceiveMessageOfType("room_joined", 5*time.Second); err != nil {
+			t.Fatalf("Failed to receive room_joined: %v", err)
+		}
+
+		client1.SendMessage("start_phase", map[string]interface{}{})
+		client1.ReceiveMessageOfType("ready_changed", 5*time.Second)
+		client1.SendMessage("mark_ready", map[string]interface{}{})
+		client1.ReceiveMessageOfType("ready_changed", 5*time.Second)
+
+		// Client2 disconnects while the phase is still waiting on them, and stays away past the turn grace
+		client2.Close()
+
+		allReadyMsg, err := client1.ReceiveMessageOfType("all_ready", 5*time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive all_ready: %v", err)
+		}
+		var allReady markready.AllReadyData
+		json.Unmarshal(allReadyMsg.Data, &allReady)
+		if len(allReady.Players) != 1 || allReady.Players[0].ClientID != createData.YourClientID {
+			t.Errorf("Expected only %s in all_ready, got %+v", createData.YourClientID, allReady.Players)
+		}
+	})
+}
+
+// sayHello agrees the latest protocol version and the given features for a client
+func sayHello(t *testing.T, client *test_helpers.TestWebSocketClient, features ...string) {
+	t.Helper()
+	client.SendMessage("hello", map[string]interface{}{
+		"protocol_version": core.ProtocolVersion,
+		"features":         features,
+	})
+	if _, err := client.ReceiveMessageOfType("welcome", 5*time.Second); err != nil {
+		t.Fatalf("Failed to receive welcome: %v", err)
+	}
 }
@@ -5,25 +5,78 @@ import (
 	"log"
 	"strings"
 	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/counters"
 	"turn-tracker/backend/handlers/createroom"
+	"turn-tracker/backend/handlers/endgame"
+	"turn-tracker/backend/handlers/gethistory"
+	"turn-tracker/backend/handlers/hello"
 	"turn-tracker/backend/handlers/joinroom"
 	"turn-tracker/backend/handlers/leaveroom"
+	"turn-tracker/backend/handlers/markready"
+	"turn-tracker/backend/handlers/nextturn"
+	"turn-tracker/backend/handlers/passturn"
+	"turn-tracker/backend/handlers/pausegame"
+	"turn-tracker/backend/handlers/randomize"
+	"turn-tracker/backend/handlers/rolldice"
+	"turn-tracker/backend/handlers/roomhost"
+	"turn-tracker/backend/handlers/roomsettings"
+	"turn-tracker/backend/handlers/scores"
+	"turn-tracker/backend/handlers/setturnorder"
 	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/handlers/teams"
+	"turn-tracker/backend/handlers/undoturn"
 	"turn-tracker/backend/handlers/updateprofile"
 	"turn-tracker/backend/types"
 )
 
+// spectatorMessages lists the message types a spectator may send
+// Everything else would change the room, so it is rejected before reaching a handler
+var spectatorMessages = map[string]bool{
+	"hello":            true,
+	"create_room":      true,
+	"join_room":        true,
+	"leave_room":       true,
+	"get_history":      true,
+	"get_score_ledger": true,
+	"get_dice_history": true,
+}
+
+// hostMessages lists the message types only the room's host may send
+var hostMessages = map[string]bool{
+	"kick_player":   true,
+	"transfer_host": true,
+	"lock_room":     true,
+	"end_game":      true,
+}
+
 // messageRouter routes incoming messages to the appropriate handler
 // Fast path: msg.Type is already extracted, just route to handler
 func messageRouter(hub *core.Hub, client *core.Client, msg *types.Message) {
+	if client.Spectator && !spectatorMessages[msg.Type] {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeForbidden, "Spectators cannot "+msg.Type)
+		client.SafeSend(errorMsg)
+		return
+	}
+	if hostMessages[msg.Type] && !isRoomHost(hub, client) {
+		errorMsg, _ := types.NewCodedErrorMessage(types.ErrorCodeForbidden, "Only the host can "+msg.Type)
+		client.SafeSend(errorMsg)
+		return
+	}
+
 	switch msg.Type {
+	case "hello":
+		var data hello.HelloData
+		if unmarshalMessageData(msg, &data, "hello", client) {
+			hello.HandleHello(hub, client, data.ProtocolVersion, data.Features)
+		}
+
 	case "create_room":
 		var data createroom.CreateRoomData
 		// RoomID is optional, so we can unmarshal even if it's missing
 		if unmarshalMessageData(msg, &data, "create_room", client) {
 			// Normalize to uppercase for consistency
 			roomID := strings.ToUpper(data.RoomID)
-			createroom.HandleCreateRoom(hub, client, roomID, data.DisplayName, data.Color)
+			createroom.HandleCreateRoom(hub, client, roomID, data.DisplayName, data.Color, data.Settings, data.PIN)
 		}
 
 	case "join_room":
@@ -31,7 +84,11 @@ func messageRouter(hub *core.Hub, client *core.Client, msg *types.Message) {
 		if unmarshalMessageData(msg, &data, "join_room", client) {
 			// Normalize to uppercase for consistency
 			roomID := strings.ToUpper(data.RoomID)
-			joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color)
+			if data.Spectator {
+				joinroom.HandleSpectateRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+			} else {
+				joinroom.HandleJoinRoom(hub, client, roomID, data.DisplayName, data.Color, data.PIN)
+			}
 		}
 
 	case "leave_room":
@@ -54,6 +111,159 @@ func messageRouter(hub *core.Hub, client *core.Client, msg *types.Message) {
 			startturn.HandleStartTurn(hub, client, data.CurrentTurn, data.NewTurn)
 		}
 
+	case "next_turn":
+		var data nextturn.NextTurnData
+		if unmarshalMessageData(msg, &data, "next_turn", client) {
+			nextturn.HandleNextTurn(hub, client, data.CurrentTurn)
+		}
+
+	case "set_turn_order":
+		var data setturnorder.SetTurnOrderData
+		if unmarshalMessageData(msg, &data, "set_turn_order", client) {
+			setturnorder.HandleSetTurnOrder(hub, client, data.Order)
+		}
+
+	case "update_room_settings":
+		var data roomsettings.UpdateRoomSettingsData
+		if unmarshalMessageData(msg, &data, "update_room_settings", client) {
+			roomsettings.HandleUpdateRoomSettings(hub, client, data.Settings)
+		}
+
+	case "end_game":
+		endgame.HandleEndGame(hub, client)
+
+	case "pause_game":
+		pausegame.HandlePauseGame(hub, client)
+
+	case "resume_game":
+		pausegame.HandleResumeGame(hub, client)
+
+	case "start_phase":
+		markready.HandleStartPhase(hub, client)
+
+	case "mark_ready":
+		markready.HandleMarkReady(hub, client)
+
+	case "pass_turn":
+		passturn.HandlePassTurn(hub, client)
+
+	case "undo_turn":
+		var data undoturn.UndoTurnData
+		if unmarshalMessageData(msg, &data, "undo_turn", client) {
+			undoturn.HandleUndoTurn(hub, client, data.CurrentTurn)
+		}
+
+	case "kick_player":
+		var data roomhost.KickPlayerData
+		if unmarshalMessageData(msg, &data, "kick_player", client) {
+			roomhost.HandleKickPlayer(hub, client, data.ClientID)
+		}
+
+	case "transfer_host":
+		var data roomhost.TransferHostData
+		if unmarshalMessageData(msg, &data, "transfer_host", client) {
+			roomhost.HandleTransferHost(hub, client, data.ClientID)
+		}
+
+	case "lock_room":
+		var data roomhost.LockRoomData
+		if unmarshalMessageData(msg, &data, "lock_room", client) {
+			roomhost.HandleLockRoom(hub, client, data.Locked)
+		}
+
+	case "create_team":
+		var data teams.CreateTeamData
+		if unmarshalMessageData(msg, &data, "create_team", client) {
+			teams.HandleCreateTeam(hub, client, data.Name, data.Color)
+		}
+
+	case "remove_team":
+		var data teams.RemoveTeamData
+		if unmarshalMessageData(msg, &data, "remove_team", client) {
+			teams.HandleRemoveTeam(hub, client, data.TeamID)
+		}
+
+	case "assign_team":
+		var data teams.AssignTeamData
+		if unmarshalMessageData(msg, &data, "assign_team", client) {
+			teams.HandleAssignTeam(hub, client, data.ClientID, data.TeamID)
+		}
+
+	case "start_team_turn":
+		var data teams.StartTeamTurnData
+		if unmarshalMessageData(msg, &data, "start_team_turn", client) {
+			teams.HandleStartTeamTurn(hub, client, data.CurrentTurn, data.TeamID)
+		}
+
+	case "change_score":
+		var data scores.ChangeScoreData
+		if unmarshalMessageData(msg, &data, "change_score", client) {
+			scores.HandleChangeScore(hub, client, data.ClientID, data.Score, data.Delta, data.Reason)
+		}
+
+	case "revert_score":
+		var data scores.RevertScoreData
+		if unmarshalMessageData(msg, &data, "revert_score", client) {
+			scores.HandleRevertScore(hub, client, data.Seq)
+		}
+
+	case "get_score_ledger":
+		var data scores.GetScoreLedgerData
+		if unmarshalMessageData(msg, &data, "get_score_ledger", client) {
+			scores.HandleGetScoreLedger(hub, client, data.Offset, data.Limit)
+		}
+
+	case "define_counter":
+		var data counters.DefineCounterData
+		if unmarshalMessageData(msg, &data, "define_counter", client) {
+			counters.HandleDefineCounter(hub, client, core.CounterDef{Name: data.Name, Min: data.Min, Max: data.Max, Default: data.Default})
+		}
+
+	case "remove_counter":
+		var data counters.RemoveCounterData
+		if unmarshalMessageData(msg, &data, "remove_counter", client) {
+			counters.HandleRemoveCounter(hub, client, data.Name)
+		}
+
+	case "adjust_counter":
+		var data counters.AdjustCounterData
+		if unmarshalMessageData(msg, &data, "adjust_counter", client) {
+			counters.HandleAdjustCounter(hub, client, data.ClientID, data.Name, data.Delta)
+		}
+
+	case "set_counter":
+		var data counters.SetCounterData
+		if unmarshalMessageData(msg, &data, "set_counter", client) {
+			counters.HandleSetCounter(hub, client, data.ClientID, data.Name, data.Value)
+		}
+
+	case "roll_dice":
+		var data rolldice.RollDiceData
+		if unmarshalMessageData(msg, &data, "roll_dice", client) {
+			rolldice.HandleRollDice(hub, client, data.Notation)
+		}
+
+	case "get_dice_history":
+		var data rolldice.GetDiceHistoryData
+		if unmarshalMessageData(msg, &data, "get_dice_history", client) {
+			rolldice.HandleGetDiceHistory(hub, client, data.Offset, data.Limit)
+		}
+
+	case "pick_first_player":
+		var data randomize.PickFirstPlayerData
+		if unmarshalMessageData(msg, &data, "pick_first_player", client) {
+			randomize.HandlePickFirstPlayer(hub, client, data.StartTurn, data.CurrentTurn)
+		}
+
+	case "shuffle_order":
+		randomize.HandleShuffleOrder(hub, client)
+
+	case "get_history":
+		var data gethistory.GetHistoryData
+		if unmarshalMessageData(msg, &data, "get_history", client) {
+			gethistory.HandleGetHistory(hub, client, data.Offset, data.Limit)
+		}
+
 	default:
 		errorMsg, err := types.NewUnknownMessageTypeError(msg.Type)
 		if err != nil {
@@ -64,11 +274,21 @@ func messageRouter(hub *core.Hub, client *core.Client, msg *types.Message) {
 	}
 }
 
+// isRoomHost reports whether the client is the host of its room
+// Clients outside a room pass, so the handler can report "Not in a room"
+func isRoomHost(hub *core.Hub, client *core.Client) bool {
+	if client.RoomID == "" {
+		return true
+	}
+	room := hub.GetRoom(client.RoomID)
+	return room == nil || room.IsHost(client.ClientID)
+}
+
 // unmarshalMessageData unmarshals message data or sends error and returns false
 func unmarshalMessageData(msg *types.Message, data interface{}, messageType string, client *core.Client) bool {
 	if err := json.Unmarshal(msg.Data, data); err != nil {
 		log.Printf("Error unmarshaling %s message: %v", messageType, err)
-		errorMsg, err := types.NewErrorMessage("Invalid " + messageType + " data")
+		errorMsg, err := types.NewCodedErrorMessage(types.ErrorCodeInvalidData, "Invalid "+messageType+" data")
 		if err != nil {
 			log.Printf("Failed to create error message: %v", err)
 			return false
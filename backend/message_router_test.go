@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"turn-tracker/backend/core"
+	"turn-tracker/backend/handlers/counters"
 	"turn-tracker/backend/handlers/createroom"
 	"turn-tracker/backend/handlers/joinroom"
+	"turn-tracker/backend/handlers/scores"
 	"turn-tracker/backend/handlers/startturn"
+	"turn-tracker/backend/handlers/teams"
 	"turn-tracker/backend/handlers/updateprofile"
 	"turn-tracker/backend/test_helpers"
 	"turn-tracker/backend/types"
@@ -24,6 +27,24 @@ func TestMessageRouter(t *testing.T) {
 	t.Run("RoutesLeaveRoom", testRoutesLeaveRoom)
 	t.Run("RoutesUpdateProfile", testRoutesUpdateProfile)
 	t.Run("RoutesStartTurn", testRoutesStartTurn)
+	t.Run("RoutesNextTurn", testRoutesNextTurn)
+	t.Run("RoutesSetTurnOrder", testRoutesSetTurnOrder)
+	t.Run("RoutesUpdateRoomSettings", testRoutesUpdateRoomSettings)
+	t.Run("RoutesPauseGame", testRoutesPauseGame)
+	t.Run("RoutesMarkReady", testRoutesMarkReady)
+	t.Run("RoutesPassTurn", testRoutesPassTurn)
+	t.Run("RoutesGetHistory", testRoutesGetHistory)
+	t.Run("RoutesUndoTurn", testRoutesUndoTurn)
+	t.Run("RoutesEndGame", testRoutesEndGame)
+	t.Run("RoutesSpectator", testRoutesSpectator)
+	t.Run("RoutesHostCommands", testRoutesHostCommands)
+	t.Run("RoutesTeams", testRoutesTeams)
+	t.Run("RoutesScores", testRoutesScores)
+	t.Run("RoutesCounters", testRoutesCounters)
+	t.Run("RoutesDice", testRoutesDice)
+	t.Run("RoutesRandomize", testRoutesRandomize)
+	t.Run("RequestIDs", testRequestIDs)
+	t.Run("RoutesHello", testRoutesHello)
 	t.Run("HandlesUnknownMessageType", testHandlesUnknownMessageType)
 	t.Run("HandlesInvalidJSON", testHandlesInvalidJSON)
 	t.Run("NormalizesRoomIDToUppercase", testNormalizesRoomIDToUppercase)
@@ -267,6 +288,112 @@ func testRoutesStartTurn(t *testing.T) {
 	}
 }
 
+func testRoutesNextTurn(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	createResp, _ := client.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	err = client.SendMessage("next_turn", map[string]interface{}{
+		"current_turn": "",
+	})
+	if err != nil {
+		t.Fatalf("Failed to send next_turn: %v", err)
+	}
+
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive turn_changed: %v", err)
+	}
+
+	if resp.Type != "turn_changed" {
+		t.Errorf("Expected 'turn_changed', got '%s'", resp.Type)
+	}
+
+	var turnData startturn.TurnChangedData
+	if err := json.Unmarshal(resp.Data, &turnData); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if turnData.CurrentTurn == nil || turnData.CurrentTurn.ClientID != createData.YourClientID {
+		t.Errorf("Expected turn for '%s', got %+v", createData.YourClientID, turnData.CurrentTurn)
+	}
+}
+
+func testRoutesSetTurnOrder(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	createResp, _ := client.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	err = client.SendMessage("set_turn_order", map[string]interface{}{
+		"order": []string{createData.YourClientID},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send set_turn_order: %v", err)
+	}
+
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive turn_order_changed: %v", err)
+	}
+
+	if resp.Type != "turn_order_changed" {
+		t.Errorf("Expected 'turn_order_changed', got '%s'", resp.Type)
+	}
+}
+
+func testRoutesUpdateRoomSettings(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	err = client.SendMessage("update_room_settings", map[string]interface{}{
+		"settings": map[string]interface{}{"turn_time_limit_ms": 60000},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send update_room_settings: %v", err)
+	}
+
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_settings_changed: %v", err)
+	}
+
+	if resp.Type != "room_settings_changed" {
+		t.Errorf("Expected 'room_settings_changed', got '%s'", resp.Type)
+	}
+}
+
 func testHandlesUnknownMessageType(t *testing.T) {
 	server := test_helpers.SetupTestServer(messageRouter)
 	defer server.Cleanup()
@@ -501,6 +628,9 @@ func TestUnmarshalMessageData(t *testing.T) {
 					if !strings.Contains(errorData.Message, "Invalid test data") {
 						t.Errorf("Expected error message to contain 'Invalid test data', got '%s'", errorData.Message)
 					}
+					if errorData.Code != types.ErrorCodeInvalidData {
+						t.Errorf("Expected code '%s', got '%s'", types.ErrorCodeInvalidData, errorData.Code)
+					}
 				}
 			}
 		case <-time.After(100 * time.Millisecond):
@@ -534,3 +664,571 @@ func TestUnmarshalMessageData(t *testing.T) {
 		}
 	})
 }
+
+func testRoutesPauseGame(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	for _, step := range []struct{ send, expect string }{
+		{"pause_game", "game_paused"},
+		{"resume_game", "game_resumed"},
+	} {
+		if err := client.SendMessage(step.send, map[string]interface{}{}); err != nil {
+			t.Fatalf("Failed to send %s: %v", step.send, err)
+		}
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive %s: %v", step.expect, err)
+		}
+		if resp.Type != step.expect {
+			t.Errorf("Expected '%s', got '%s'", step.expect, resp.Type)
+		}
+	}
+}
+
+func testRoutesMarkReady(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{
+		"settings": map[string]interface{}{"turn_mode": "simultaneous"},
+	})
+	client.ReceiveMessage(5 * time.Second)
+
+	for _, step := range []struct{ send, expect string }{
+		{"start_phase", "ready_changed"},
+		{"mark_ready", "all_ready"},
+	} {
+		if err := client.SendMessage(step.send, map[string]interface{}{}); err != nil {
+			t.Fatalf("Failed to send %s: %v", step.send, err)
+		}
+		resp, err := client.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive %s: %v", step.expect, err)
+		}
+		if resp.Type != step.expect {
+			t.Errorf("Expected '%s', got '%s'", step.expect, resp.Type)
+		}
+	}
+}
+
+func testRoutesPassTurn(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	createResp, _ := client.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	client.SendMessage("start_turn", map[string]interface{}{"new_turn": createData.YourClientID})
+	client.ReceiveMessage(5 * time.Second)
+
+	err = client.SendMessage("pass_turn", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Failed to send pass_turn: %v", err)
+	}
+
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive player_passed: %v", err)
+	}
+
+	if resp.Type != "player_passed" {
+		t.Errorf("Expected 'player_passed', got '%s'", resp.Type)
+	}
+}
+
+func testRoutesGetHistory(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	err = client.SendMessage("get_history", map[string]interface{}{"limit": 10})
+	if err != nil {
+		t.Fatalf("Failed to send get_history: %v", err)
+	}
+
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive history: %v", err)
+	}
+
+	if resp.Type != "history" {
+		t.Errorf("Expected 'history', got '%s'", resp.Type)
+	}
+}
+
+func testRoutesUndoTurn(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{
+		"settings": map[string]interface{}{"undo_depth": 1},
+	})
+	createResp, _ := client.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	client.SendMessage("start_turn", map[string]interface{}{"new_turn": createData.YourClientID})
+	client.ReceiveMessage(5 * time.Second)
+
+	err = client.SendMessage("undo_turn", map[string]interface{}{"current_turn": createData.YourClientID})
+	if err != nil {
+		t.Fatalf("Failed to send undo_turn: %v", err)
+	}
+
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive turn_changed: %v", err)
+	}
+
+	var turnData startturn.TurnChangedData
+	json.Unmarshal(resp.Data, &turnData)
+	if resp.Type != "turn_changed" || turnData.CurrentTurn != nil {
+		t.Errorf("Expected turn_changed with no active turn, got '%s' %+v", resp.Type, turnData.CurrentTurn)
+	}
+}
+
+func testRoutesEndGame(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	err = client.SendMessage("end_game", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Failed to send end_game: %v", err)
+	}
+
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive game_ended: %v", err)
+	}
+	if resp.Type != "game_ended" {
+		t.Errorf("Expected 'game_ended', got '%s'", resp.Type)
+	}
+}
+
+func testRoutesSpectator(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	host, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer host.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	host.SendMessage("create_room", map[string]interface{}{})
+	createResp, _ := host.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	spectator, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer spectator.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	spectator.SendMessage("join_room", map[string]interface{}{
+		"room_id":   createData.RoomID,
+		"spectator": true,
+	})
+	resp, err := spectator.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_joined: %v", err)
+	}
+	var joinData joinroom.RoomJoinedData
+	json.Unmarshal(resp.Data, &joinData)
+	if resp.Type != "room_joined" || !joinData.Spectator {
+		t.Fatalf("Expected room_joined as a spectator, got '%s' %+v", resp.Type, joinData)
+	}
+
+	// Anything that changes the room is rejected before reaching a handler
+	for _, msgType := range []string{"start_turn", "update_profile", "end_game"} {
+		spectator.SendMessage(msgType, map[string]interface{}{"new_turn": joinData.YourClientID})
+		resp, err := spectator.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error for %s: %v", msgType, err)
+		}
+		var errorData types.ErrorData
+		json.Unmarshal(resp.Data, &errorData)
+		if resp.Type != "error" || errorData.Message != "Spectators cannot "+msgType || errorData.Code != types.ErrorCodeForbidden {
+			t.Errorf("Expected '%s' spectator error for %s, got '%s' %+v", types.ErrorCodeForbidden, msgType, resp.Type, errorData)
+		}
+	}
+}
+
+func testRoutesHostCommands(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	host, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer host.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	host.SendMessage("create_room", map[string]interface{}{})
+	createResp, _ := host.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	player, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer player.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	player.SendMessage("join_room", map[string]interface{}{"room_id": createData.RoomID})
+	player.ReceiveMessage(5 * time.Second)
+	host.ReceiveMessage(5 * time.Second) // player_joined
+
+	// Only the host gets past the router
+	for _, msgType := range []string{"kick_player", "transfer_host", "lock_room", "end_game"} {
+		player.SendMessage(msgType, map[string]interface{}{"client_id": createData.YourClientID, "locked": true})
+		resp, err := player.ReceiveMessage(5 * time.Second)
+		if err != nil {
+			t.Fatalf("Failed to receive error for %s: %v", msgType, err)
+		}
+		var errorData types.ErrorData
+		json.Unmarshal(resp.Data, &errorData)
+		if resp.Type != "error" || errorData.Message != "Only the host can "+msgType || errorData.Code != types.ErrorCodeForbidden {
+			t.Errorf("Expected '%s' host-only error for %s, got '%s' %+v", types.ErrorCodeForbidden, msgType, resp.Type, errorData)
+		}
+	}
+
+	host.SendMessage("lock_room", map[string]interface{}{"locked": true})
+	resp, err := host.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive room_lock_changed: %v", err)
+	}
+	if resp.Type != "room_lock_changed" {
+		t.Errorf("Expected 'room_lock_changed', got '%s'", resp.Type)
+	}
+}
+
+func testRoutesTeams(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	createResp, _ := client.ReceiveMessage(5 * time.Second)
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(createResp.Data, &createData)
+
+	client.SendMessage("create_team", map[string]interface{}{"name": "Red"})
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive teams_changed: %v", err)
+	}
+	if resp.Type != "teams_changed" {
+		t.Fatalf("Expected 'teams_changed', got '%s'", resp.Type)
+	}
+	var teamsData teams.TeamsChangedData
+	json.Unmarshal(resp.Data, &teamsData)
+	teamID := teamsData.Teams[0].TeamID
+
+	client.SendMessage("assign_team", map[string]interface{}{"team_id": teamID})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "teams_changed" {
+		t.Errorf("Expected 'teams_changed' after assign_team, got '%s'", resp.Type)
+	}
+
+	client.SendMessage("start_team_turn", map[string]interface{}{"current_turn": "", "team_id": teamID})
+	resp, err = client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive turn_changed: %v", err)
+	}
+	var turnData startturn.TurnChangedData
+	json.Unmarshal(resp.Data, &turnData)
+	if resp.Type != "turn_changed" || turnData.CurrentTeam == nil || turnData.CurrentTeam.TeamID != teamID {
+		t.Errorf("Expected turn_changed for team %s, got '%s' %+v", teamID, resp.Type, turnData.CurrentTeam)
+	}
+
+	client.SendMessage("remove_team", map[string]interface{}{"team_id": teamID})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "teams_changed" {
+		t.Errorf("Expected 'teams_changed' after remove_team, got '%s'", resp.Type)
+	}
+}
+
+func testRoutesScores(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	client.SendMessage("change_score", map[string]interface{}{"delta": 3})
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive score_changed: %v", err)
+	}
+	if resp.Type != "score_changed" {
+		t.Fatalf("Expected 'score_changed', got '%s'", resp.Type)
+	}
+	var changed scores.ScoreChangedData
+	json.Unmarshal(resp.Data, &changed)
+
+	client.SendMessage("revert_score", map[string]interface{}{"seq": changed.Seq})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "score_changed" {
+		t.Errorf("Expected 'score_changed' after revert_score, got '%s'", resp.Type)
+	}
+
+	client.SendMessage("get_score_ledger", map[string]interface{}{})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "score_ledger" {
+		t.Errorf("Expected 'score_ledger', got '%s'", resp.Type)
+	}
+}
+
+func testRoutesCounters(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	client.SendMessage("define_counter", map[string]interface{}{"name": "Wood", "min": 0})
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive counters_changed: %v", err)
+	}
+	if resp.Type != "counters_changed" {
+		t.Fatalf("Expected 'counters_changed', got '%s'", resp.Type)
+	}
+
+	client.SendMessage("adjust_counter", map[string]interface{}{"name": "Wood", "delta": 2})
+	resp, err = client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive counter_changed: %v", err)
+	}
+	var changed counters.CounterChangedData
+	json.Unmarshal(resp.Data, &changed)
+	if resp.Type != "counter_changed" || changed.Value != 2 {
+		t.Errorf("Expected counter_changed with value 2, got '%s' %+v", resp.Type, changed)
+	}
+
+	client.SendMessage("set_counter", map[string]interface{}{"name": "Wood", "value": 0})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "counter_changed" {
+		t.Errorf("Expected 'counter_changed' after set_counter, got '%s'", resp.Type)
+	}
+
+	client.SendMessage("remove_counter", map[string]interface{}{"name": "Wood"})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "counters_changed" {
+		t.Errorf("Expected 'counters_changed' after remove_counter, got '%s'", resp.Type)
+	}
+}
+
+func testRoutesDice(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	client.SendMessage("roll_dice", map[string]interface{}{"notation": "1d20+3"})
+	resp, err := client.ReceiveMessage(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive dice_rolled: %v", err)
+	}
+	if resp.Type != "dice_rolled" {
+		t.Errorf("Expected 'dice_rolled', got '%s'", resp.Type)
+	}
+
+	client.SendMessage("get_dice_history", map[string]interface{}{})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "dice_history" {
+		t.Errorf("Expected 'dice_history', got '%s'", resp.Type)
+	}
+}
+
+func testRoutesRandomize(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("create_room", map[string]interface{}{})
+	client.ReceiveMessage(5 * time.Second)
+
+	client.SendMessage("pick_first_player", map[string]interface{}{})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "first_player_picked" {
+		t.Errorf("Expected 'first_player_picked', got '%s'", resp.Type)
+	}
+
+	client.SendMessage("shuffle_order", map[string]interface{}{})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "turn_order_shuffled" {
+		t.Errorf("Expected 'turn_order_shuffled', got '%s'", resp.Type)
+	}
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "turn_order_changed" {
+		t.Errorf("Expected 'turn_order_changed' after the shuffle, got '%s'", resp.Type)
+	}
+}
+
+func testRequestIDs(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	// The reply carries the id, and the ack follows it
+	client.SendRequest("create_room", "req-1", map[string]interface{}{})
+	resp, _ := client.ReceiveMessage(5 * time.Second)
+	if resp.Type != "room_created" || resp.ID != "req-1" {
+		t.Errorf("Expected 'room_created' with id 'req-1', got '%s' with id '%s'", resp.Type, resp.ID)
+	}
+	var createData createroom.RoomCreatedData
+	json.Unmarshal(resp.Data, &createData)
+	resp, _ = client.ReceiveMessage(5 * time.Second)
+	var ack types.AckData
+	json.Unmarshal(resp.Data, &ack)
+	if resp.Type != "ack" || resp.ID != "req-1" || ack.Type != "create_room" {
+		t.Errorf("Expected ack of 'create_room' with id 'req-1', got '%s' (%+v) with id '%s'", resp.Type, ack, resp.ID)
+	}
+
+	// Broadcasts caused by the request carry its id
+	client.SendRequest("start_turn", "req-2", map[string]interface{}{"new_turn": createData.YourClientID})
+	resp, _ = client.ReceiveMessage(5 * time.Second)
+	if resp.Type != "turn_changed" || resp.ID != "req-2" {
+		t.Errorf("Expected 'turn_changed' with id 'req-2', got '%s' with id '%s'", resp.Type, resp.ID)
+	}
+	if resp, _ = client.ReceiveMessage(5 * time.Second); resp.Type != "ack" || resp.ID != "req-2" {
+		t.Errorf("Expected 'ack' with id 'req-2', got '%s' with id '%s'", resp.Type, resp.ID)
+	}
+
+	// A start_turn that changes nothing is answered with a typed error instead of silence
+	client.SendRequest("start_turn", "req-3", map[string]interface{}{
+		"current_turn": createData.YourClientID,
+		"new_turn":     createData.YourClientID,
+	})
+	resp, _ = client.ReceiveMessage(5 * time.Second)
+	var errorData types.ErrorData
+	json.Unmarshal(resp.Data, &errorData)
+	if resp.Type != "error" || resp.ID != "req-3" || errorData.Code != types.ErrorCodeNoChange {
+		t.Errorf("Expected '%s' error with id 'req-3', got '%s' (%+v) with id '%s'", types.ErrorCodeNoChange, resp.Type, errorData, resp.ID)
+	}
+
+	// Errors echo the id, and are not followed by an ack
+	client.SendRequest("no_such_message", "req-4", map[string]interface{}{})
+	if resp, _ = client.ReceiveMessage(5 * time.Second); resp.Type != "error" || resp.ID != "req-4" {
+		t.Errorf("Expected 'error' with id 'req-4', got '%s' with id '%s'", resp.Type, resp.ID)
+	}
+	client.SendMessage("get_history", map[string]interface{}{})
+	if resp, _ = client.ReceiveMessage(5 * time.Second); resp.Type != "history" || resp.ID != "" {
+		t.Errorf("Expected 'history' without an id (no ack for req-4), got '%s' with id '%s'", resp.Type, resp.ID)
+	}
+	if resp, err := client.ReceiveMessage(200 * time.Millisecond); err == nil {
+		t.Errorf("Expected no ack for a message without an id, got '%s'", resp.Type)
+	}
+}
+
+func testRoutesHello(t *testing.T) {
+	server := test_helpers.SetupTestServer(messageRouter)
+	defer server.Cleanup()
+
+	client, err := test_helpers.ConnectTestClient(server.Server.URL)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	client.SendMessage("hello", map[string]interface{}{"protocol_version": core.ProtocolVersion})
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "welcome" {
+		t.Errorf("Expected 'welcome', got '%s'", resp.Type)
+	}
+
+	client.SendMessage("hello", "not an object")
+	if resp, _ := client.ReceiveMessage(5 * time.Second); resp.Type != "error" {
+		t.Errorf("Expected 'error' for invalid hello data, got '%s'", resp.Type)
+	}
+}
@@ -0,0 +1,82 @@
+package test_helpers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"turn-tracker/backend/types"
+)
+
+// TestRoom is a room set up by SetupRoom
+type TestRoom struct {
+	ID        string
+	Clients   []*TestWebSocketClient // In seat order - the first created the room and is its host
+	ClientIDs []string               // The clients' IDs, in the same order
+}
+
+// roomEntryData is the part of room_created and room_joined that SetupRoom reads
+// Decoded here rather than with the handlers' types, which would import them into their own tests
+type roomEntryData struct {
+	RoomID       string `json:"room_id"`
+	YourClientID string `json:"your_client_id"`
+	Host         string `json:"host"`
+}
+
+// SetupRoom connects players clients: the first creates a room with the given settings (nil for the
+// defaults) and the others join it in turn
+func SetupRoom(t *testing.T, server *TestServer, players int, settings map[string]interface{}) *TestRoom {
+	t.Helper()
+
+	room := &TestRoom{}
+	for i := 0; i < players; i++ {
+		client, err := ConnectTestClient(server.Server.URL)
+		if err != nil {
+			t.Fatalf("Failed to connect client %d: %v", i, err)
+		}
+		time.Sleep(100 * time.Millisecond)
+
+		var entry roomEntryData
+		if i == 0 {
+			client.SendMessage("create_room", map[string]interface{}{"settings": settings})
+			entry = ReceiveData[roomEntryData](t, client, "room_created")
+			room.ID = entry.RoomID
+		} else {
+			client.SendMessage("join_room", map[string]interface{}{"room_id": room.ID})
+			entry = ReceiveData[roomEntryData](t, client, "room_joined")
+			if entry.Host != room.ClientIDs[0] {
+				t.Fatalf("Expected the creator to be host, got %s", entry.Host)
+			}
+		}
+		room.Clients = append(room.Clients, client)
+		room.ClientIDs = append(room.ClientIDs, entry.YourClientID)
+	}
+	return room
+}
+
+// Close closes every client in the room
+func (r *TestRoom) Close() {
+	for _, client := range r.Clients {
+		client.Close()
+	}
+}
+
+// ReceiveData waits for a message of the given type and decodes its data
+func ReceiveData[T any](t *testing.T, client *TestWebSocketClient, msgType string) T {
+	t.Helper()
+	var data T
+	resp, err := client.ReceiveMessageOfType(msgType, 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to receive %s: %v", msgType, err)
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("Failed to unmarshal %s: %v", msgType, err)
+	}
+	return data
+}
+
+// ReceiveError waits for an error message
+func ReceiveError(t *testing.T, client *TestWebSocketClient) types.ErrorData {
+	t.Helper()
+	return ReceiveData[types.ErrorData](t, client, "error")
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"time"
 
 	"turn-tracker/backend/core"
@@ -83,7 +84,18 @@ type TestWebSocketClient struct {
 
 // ConnectTestClient creates and connects a test WebSocket client
 func ConnectTestClient(serverURL string) (*TestWebSocketClient, error) {
-	u := "ws" + serverURL[4:] + "/ws"
+	return dialTestClient("ws" + serverURL[4:] + "/ws")
+}
+
+// ConnectTestClientWithID connects a test WebSocket client that asks to reconnect as clientID
+// Only servers that read the client_id and resume_token query parameters honour it
+func ConnectTestClientWithID(serverURL, clientID, resumeToken string) (*TestWebSocketClient, error) {
+	query := url.Values{"client_id": {clientID}, "resume_token": {resumeToken}}
+	return dialTestClient("ws" + serverURL[4:] + "/ws?" + query.Encode())
+}
+
+// dialTestClient dials u and starts reading its messages
+func dialTestClient(u string) (*TestWebSocketClient, error) {
 	conn, _, err := websocket.DefaultDialer.Dial(u, nil)
 	if err != nil {
 		return nil, err
@@ -126,8 +138,14 @@ func ConnectTestClient(serverURL string) (*TestWebSocketClient, error) {
 
 // SendMessage sends a JSON message to the server
 func (c *TestWebSocketClient) SendMessage(msgType string, data interface{}) error {
+	return c.SendRequest(msgType, "", data)
+}
+
+// SendRequest sends a JSON message with a request id to the server
+func (c *TestWebSocketClient) SendRequest(msgType, id string, data interface{}) error {
 	msg := types.Message{
 		Type: msgType,
+		ID:   id,
 	}
 
 	dataBytes, err := json.Marshal(data)
@@ -151,6 +169,24 @@ func (c *TestWebSocketClient) ReceiveMessage(timeout time.Duration) (types.Messa
 	}
 }
 
+// ReceiveMessageOfType waits for a message of the given type, discarding any others received first
+func (c *TestWebSocketClient) ReceiveMessageOfType(msgType string, timeout time.Duration) (types.Message, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return types.Message{}, fmt.Errorf("timeout waiting for %s message", msgType)
+		}
+		msg, err := c.ReceiveMessage(remaining)
+		if err != nil {
+			return types.Message{}, err
+		}
+		if msg.Type == msgType {
+			return msg, nil
+		}
+	}
+}
+
 // Close closes the WebSocket connection
 func (c *TestWebSocketClient) Close() error {
 	return c.Conn.Close()
@@ -158,7 +194,7 @@ func (c *TestWebSocketClient) Close() error {
 
 // Cleanup shuts down the test server
 func (ts *TestServer) Cleanup() {
-	ts.Hub.Shutdown() // Gracefully shutdown hub first
+	ts.Hub.Shutdown()                 // Gracefully shutdown hub first
 	time.Sleep(50 * time.Millisecond) // Give goroutines time to exit
 	ts.Server.Close()
 }
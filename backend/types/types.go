@@ -9,22 +9,47 @@ import (
 type Message struct {
 	Type string          `json:"type"`
 	Data json.RawMessage `json:"data"`
+	ID   string          `json:"id,omitempty"` // Optional request id, echoed in the ack or error that answers the request and in the broadcasts it causes
+}
+
+// AckData is the data structure for ack messages
+// Sent when a message with an id was handled without an error
+type AckData struct {
+	Type string `json:"type"` // Type of the acknowledged message
 }
 
 // ErrorData is the data structure for error messages
 type ErrorData struct {
 	Message string `json:"message"`
+	Code    string `json:"code,omitempty"` // Machine-readable reason (empty for untyped errors)
 }
 
+// Error codes let clients tell rejections apart without matching on the message
+const (
+	ErrorCodeTurnControl         = "turn_control"         // The room's turn control policy does not let the client change the turn
+	ErrorCodeStateMismatch       = "state_mismatch"       // The client's view of the room was stale - the current state was sent instead
+	ErrorCodeNoChange            = "no_change"            // The request was valid but asked for what is already the case
+	ErrorCodeUnsupportedProtocol = "unsupported_protocol" // The client's protocol version is older than the server still speaks
+	ErrorCodeInvalidData         = "invalid_data"         // The message data could not be parsed or failed validation
+	ErrorCodeRoomNotFound        = "room_not_found"       // The room does not exist (or was deleted)
+	ErrorCodeNotInRoom           = "not_in_room"          // The message needs the client to be in a room
+	ErrorCodeForbidden           = "forbidden"            // The client is not allowed to do this (spectators, non-hosts, kicked players, wrong PIN)
+	ErrorCodeGameEnded           = "game_ended"           // The room's game has ended, so turns can no longer change
+	ErrorCodeGamePaused          = "game_paused"          // The room's game is paused
+	ErrorCodeRateLimited         = "rate_limited"         // The client sent too many messages
+	ErrorCodeRejected            = "rejected"             // The room's rules do not allow the request right now (the message says why)
+)
+
 var (
 	// Cached error messages for common errors to avoid repeated JSON marshaling
-	cachedErrors = map[string][]byte{
-		"Invalid message format":    nil, // Lazy init
-		"Room not found":            nil,
-		"Room already exists":       nil,
-		"Invalid create_room data":  nil,
-		"Invalid join_room data":    nil,
-		"Not a member of this room": nil,
+	cachedErrors = map[ErrorData][]byte{
+		{Message: "Invalid message format", Code: ErrorCodeInvalidData}:   nil, // Lazy init
+		{Message: "Room not found", Code: ErrorCodeRoomNotFound}:          nil,
+		{Message: "Not in a room", Code: ErrorCodeNotInRoom}:              nil,
+		{Message: "Room already exists", Code: ErrorCodeRejected}:         nil,
+		{Message: "Invalid create_room data", Code: ErrorCodeInvalidData}: nil,
+		{Message: "Invalid join_room data", Code: ErrorCodeInvalidData}:   nil,
+		{Message: "Not a member of this room"}:                            nil,
 	}
 	cacheMutex sync.RWMutex
 	cacheInit  sync.Once
@@ -33,24 +58,41 @@ var (
 // Pre-allocated string builder capacity for unknown message type errors
 const unknownMsgPrefix = "Unknown message type: "
 
-// NewErrorMessage creates an error message, using cached versions when available
+// NewErrorMessage creates an error message without a code, using cached versions when available
+func NewErrorMessage(message string) ([]byte, error) {
+	return NewCodedErrorMessage("", message)
+}
+
+// NewUnknownMessageTypeError creates an error for unknown message types using pre-allocated buffer
+func NewUnknownMessageTypeError(msgType string) ([]byte, error) {
+	// Pre-allocated string concatenation - calculate exact size upfront
+	buf := make([]byte, 0, len(unknownMsgPrefix)+len(msgType))
+	buf = append(buf, unknownMsgPrefix...)
+	buf = append(buf, msgType...)
+	return NewErrorMessage(string(buf))
+}
+
+// NewCodedErrorMessage creates an error message with a machine-readable code, using cached versions when available
 // Note: Cached errors are already optimized (marshaled once), so we don't need
 // to use the memory pool here. The pool is used for messages that are created
 // frequently and sent via WritePump, which pools them after sending.
-func NewErrorMessage(message string) ([]byte, error) {
+func NewCodedErrorMessage(code, message string) ([]byte, error) {
+	data := ErrorData{
+		Message: message,
+		Code:    code,
+	}
+
 	cacheMutex.RLock()
-	cached, exists := cachedErrors[message]
+	cached, exists := cachedErrors[data]
 	cacheMutex.RUnlock()
 
-	// Return cached version if available
+	// Return a copy of the cached version if available
+	// WritePump returns sent messages to the buffer pool, so the cached bytes must never be handed out directly
 	if exists && cached != nil {
-		return cached, nil
+		return append([]byte(nil), cached...), nil
 	}
 
 	// Create new error message
-	data := ErrorData{
-		Message: message,
-	}
 	dataJSON, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -67,18 +109,23 @@ func NewErrorMessage(message string) ([]byte, error) {
 	// Cache it if it's a known common error
 	if exists {
 		cacheMutex.Lock()
-		cachedErrors[message] = result
+		cachedErrors[data] = result
 		cacheMutex.Unlock()
 	}
 
 	return result, nil
 }
 
-// NewUnknownMessageTypeError creates an error for unknown message types using pre-allocated buffer
-func NewUnknownMessageTypeError(msgType string) ([]byte, error) {
-	// Pre-allocated string concatenation - calculate exact size upfront
-	buf := make([]byte, 0, len(unknownMsgPrefix)+len(msgType))
-	buf = append(buf, unknownMsgPrefix...)
-	buf = append(buf, msgType...)
-	return NewErrorMessage(string(buf))
+// NewAckMessage creates an ack message answering the request with the given id
+func NewAckMessage(id, msgType string) ([]byte, error) {
+	dataJSON, err := json.Marshal(AckData{Type: msgType})
+	if err != nil {
+		return nil, err
+	}
+	msg := Message{
+		Type: "ack",
+		Data: dataJSON,
+		ID:   id,
+	}
+	return json.Marshal(msg)
 }